@@ -3,6 +3,7 @@ package main
 import (
 	"flag"
 	"pr-review/internal/app"
+	_ "time/tzdata"
 )
 
 var (
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS pr_review.team_rotation (
+    team_id BIGINT PRIMARY KEY REFERENCES pr_review.team(id) ON DELETE CASCADE,
+    last_user_id VARCHAR(255),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS pr_review.team_settings (
+    team_id BIGINT PRIMARY KEY REFERENCES pr_review.team(id) ON DELETE CASCADE,
+    reviewers_count INT NOT NULL DEFAULT 2 CHECK (reviewers_count >= 0),
+    min_approvals INT NOT NULL DEFAULT 0 CHECK (min_approvals >= 0),
+    strategy VARCHAR(50) NOT NULL DEFAULT '',
+    fallback VARCHAR(20) NOT NULL DEFAULT 'ASSIGN_AVAILABLE' CHECK (fallback IN ('ASSIGN_AVAILABLE', 'REJECT')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
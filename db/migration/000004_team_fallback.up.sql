@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS pr_review.team_fallback (
+    team_id BIGINT NOT NULL REFERENCES pr_review.team(id) ON DELETE CASCADE,
+    fallback_team_id BIGINT NOT NULL REFERENCES pr_review.team(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    PRIMARY KEY (team_id, fallback_team_id),
+    CHECK (team_id <> fallback_team_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_fallback_team_id ON pr_review.team_fallback(team_id, position);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS pr_review.user_out_of_office;
@@ -0,0 +1,11 @@
+ALTER TABLE pr_review.team_settings DROP CONSTRAINT IF EXISTS team_settings_fallback_check;
+UPDATE pr_review.team_settings SET fallback = 'ASSIGN_AVAILABLE' WHERE fallback = 'UNDERSTAFFED';
+ALTER TABLE pr_review.team_settings ADD CONSTRAINT team_settings_fallback_check
+    CHECK (fallback IN ('ASSIGN_AVAILABLE', 'REJECT'));
+
+UPDATE pr_review.pull_request SET status = 'OPEN' WHERE status = 'UNDERSTAFFED';
+ALTER TABLE pr_review.pull_request DROP CONSTRAINT IF EXISTS pull_request_status_check;
+ALTER TABLE pr_review.pull_request ADD CONSTRAINT pull_request_status_check
+    CHECK (status IN ('OPEN', 'MERGED'));
+
+ALTER TABLE pr_review.user DROP COLUMN IF EXISTS max_open_reviews;
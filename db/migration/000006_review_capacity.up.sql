@@ -0,0 +1,9 @@
+ALTER TABLE pr_review.user ADD COLUMN IF NOT EXISTS max_open_reviews INT CHECK (max_open_reviews >= 0);
+
+ALTER TABLE pr_review.pull_request DROP CONSTRAINT IF EXISTS pull_request_status_check;
+ALTER TABLE pr_review.pull_request ADD CONSTRAINT pull_request_status_check
+    CHECK (status IN ('OPEN', 'UNDERSTAFFED', 'MERGED'));
+
+ALTER TABLE pr_review.team_settings DROP CONSTRAINT IF EXISTS team_settings_fallback_check;
+ALTER TABLE pr_review.team_settings ADD CONSTRAINT team_settings_fallback_check
+    CHECK (fallback IN ('ASSIGN_AVAILABLE', 'UNDERSTAFFED', 'REJECT'));
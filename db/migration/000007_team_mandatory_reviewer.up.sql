@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS pr_review.team_mandatory_reviewer (
+    team_id BIGINT NOT NULL REFERENCES pr_review.team(id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL REFERENCES pr_review.user(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    PRIMARY KEY (team_id, user_id)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS pr_review.team_codeowners (
+    id BIGSERIAL PRIMARY KEY,
+    team_id BIGINT NOT NULL REFERENCES pr_review.team(id) ON DELETE CASCADE,
+    version INT NOT NULL,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (team_id, version)
+);
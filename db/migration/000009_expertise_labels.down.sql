@@ -0,0 +1,3 @@
+ALTER TABLE pr_review.pull_request DROP COLUMN IF EXISTS labels;
+
+ALTER TABLE pr_review.user DROP COLUMN IF EXISTS expertise;
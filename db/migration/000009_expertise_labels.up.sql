@@ -0,0 +1,3 @@
+ALTER TABLE pr_review.user ADD COLUMN IF NOT EXISTS expertise TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE pr_review.pull_request ADD COLUMN IF NOT EXISTS labels TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,3 @@
+ALTER TABLE pr_review.team_settings DROP COLUMN IF EXISTS require_senior;
+
+ALTER TABLE pr_review.user DROP COLUMN IF EXISTS seniority;
@@ -0,0 +1,4 @@
+ALTER TABLE pr_review.user ADD COLUMN IF NOT EXISTS seniority VARCHAR(16) NOT NULL DEFAULT 'middle'
+    CHECK (seniority IN ('junior', 'middle', 'senior'));
+
+ALTER TABLE pr_review.team_settings ADD COLUMN IF NOT EXISTS require_senior BOOLEAN NOT NULL DEFAULT FALSE;
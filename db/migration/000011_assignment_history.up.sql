@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS pr_review.assignment_history (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id BIGINT NOT NULL REFERENCES pr_review.pull_request(id) ON DELETE CASCADE,
+    author_id VARCHAR(255) NOT NULL,
+    reviewer_id VARCHAR(255) NOT NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('ASSIGNED', 'UNASSIGNED')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_assignment_history_pull_request_id ON pr_review.assignment_history(pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_assignment_history_author_id ON pr_review.assignment_history(author_id, reviewer_id);
@@ -0,0 +1,5 @@
+ALTER TABLE pr_review.team_settings DROP COLUMN IF EXISTS prefer_working_hours;
+
+ALTER TABLE pr_review.user DROP COLUMN IF EXISTS work_end;
+ALTER TABLE pr_review.user DROP COLUMN IF EXISTS work_start;
+ALTER TABLE pr_review.user DROP COLUMN IF EXISTS time_zone;
@@ -0,0 +1,5 @@
+ALTER TABLE pr_review.user ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+ALTER TABLE pr_review.user ADD COLUMN IF NOT EXISTS work_start VARCHAR(5);
+ALTER TABLE pr_review.user ADD COLUMN IF NOT EXISTS work_end VARCHAR(5);
+
+ALTER TABLE pr_review.team_settings ADD COLUMN IF NOT EXISTS prefer_working_hours BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1 @@
+ALTER TABLE pr_review.user DROP COLUMN IF EXISTS review_weight;
@@ -0,0 +1,2 @@
+ALTER TABLE pr_review.user ADD COLUMN IF NOT EXISTS review_weight DOUBLE PRECISION NOT NULL DEFAULT 1
+    CHECK (review_weight > 0);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS pr_review.team_size_rule;
+
+ALTER TABLE pr_review.pull_request DROP COLUMN IF EXISTS files_changed;
+ALTER TABLE pr_review.pull_request DROP COLUMN IF EXISTS lines_removed;
+ALTER TABLE pr_review.pull_request DROP COLUMN IF EXISTS lines_added;
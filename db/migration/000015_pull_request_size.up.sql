@@ -0,0 +1,12 @@
+ALTER TABLE pr_review.pull_request ADD COLUMN IF NOT EXISTS lines_added INT;
+ALTER TABLE pr_review.pull_request ADD COLUMN IF NOT EXISTS lines_removed INT;
+ALTER TABLE pr_review.pull_request ADD COLUMN IF NOT EXISTS files_changed INT;
+
+CREATE TABLE IF NOT EXISTS pr_review.team_size_rule (
+    team_id BIGINT NOT NULL REFERENCES pr_review.team(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    max_lines INT NOT NULL DEFAULT 0,
+    max_files INT NOT NULL DEFAULT 0,
+    reviewers_count INT NOT NULL,
+    PRIMARY KEY (team_id, position)
+);
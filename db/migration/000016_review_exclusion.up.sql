@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS pr_review.review_exclusion (
+    id BIGSERIAL PRIMARY KEY,
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES pr_review.user(id) ON DELETE CASCADE,
+    author_id VARCHAR(255) NOT NULL REFERENCES pr_review.user(id) ON DELETE CASCADE,
+    symmetric BOOLEAN NOT NULL DEFAULT TRUE,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (reviewer_id, author_id),
+    CHECK (reviewer_id <> author_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_exclusion_author_id ON pr_review.review_exclusion(author_id);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS pr_review.review_verdict (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id BIGINT NOT NULL REFERENCES pr_review.pull_request(id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES pr_review.user(id) ON DELETE CASCADE,
+    verdict VARCHAR(32) NOT NULL CHECK (verdict IN ('APPROVED', 'CHANGES_REQUESTED', 'COMMENTED')),
+    body TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_verdict_pull_request_id ON pr_review.review_verdict(pull_request_id, reviewer_id, id);
@@ -0,0 +1,5 @@
+ALTER TABLE pr_review.pull_request DROP COLUMN IF EXISTS merge_blockers;
+ALTER TABLE pr_review.pull_request DROP COLUMN IF EXISTS forced_merge;
+
+ALTER TABLE pr_review.team_settings DROP COLUMN IF EXISTS require_mandatory_approval;
+ALTER TABLE pr_review.team_settings DROP COLUMN IF EXISTS block_on_changes_requested;
@@ -0,0 +1,5 @@
+ALTER TABLE pr_review.team_settings ADD COLUMN IF NOT EXISTS block_on_changes_requested BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE pr_review.team_settings ADD COLUMN IF NOT EXISTS require_mandatory_approval BOOLEAN NOT NULL DEFAULT TRUE;
+
+ALTER TABLE pr_review.pull_request ADD COLUMN IF NOT EXISTS forced_merge BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE pr_review.pull_request ADD COLUMN IF NOT EXISTS merge_blockers TEXT[] NOT NULL DEFAULT '{}';
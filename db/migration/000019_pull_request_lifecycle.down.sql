@@ -0,0 +1,4 @@
+UPDATE pr_review.pull_request SET status = 'OPEN' WHERE status IN ('DRAFT', 'CLOSED');
+ALTER TABLE pr_review.pull_request DROP CONSTRAINT IF EXISTS pull_request_status_check;
+ALTER TABLE pr_review.pull_request ADD CONSTRAINT pull_request_status_check
+    CHECK (status IN ('OPEN', 'UNDERSTAFFED', 'MERGED'));
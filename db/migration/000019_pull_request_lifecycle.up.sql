@@ -0,0 +1,3 @@
+ALTER TABLE pr_review.pull_request DROP CONSTRAINT IF EXISTS pull_request_status_check;
+ALTER TABLE pr_review.pull_request ADD CONSTRAINT pull_request_status_check
+    CHECK (status IN ('DRAFT', 'OPEN', 'UNDERSTAFFED', 'MERGED', 'CLOSED'));
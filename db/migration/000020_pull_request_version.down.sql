@@ -0,0 +1 @@
+ALTER TABLE pr_review.pull_request DROP COLUMN IF EXISTS version;
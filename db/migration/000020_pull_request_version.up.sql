@@ -0,0 +1 @@
+ALTER TABLE pr_review.pull_request ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
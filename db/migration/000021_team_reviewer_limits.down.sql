@@ -0,0 +1,2 @@
+ALTER TABLE pr_review.team_settings DROP COLUMN IF EXISTS max_reviewers;
+ALTER TABLE pr_review.team_settings DROP COLUMN IF EXISTS min_reviewers;
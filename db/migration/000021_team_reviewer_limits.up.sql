@@ -0,0 +1,2 @@
+ALTER TABLE pr_review.team_settings ADD COLUMN IF NOT EXISTS min_reviewers INT NOT NULL DEFAULT 0 CHECK (min_reviewers >= 0);
+ALTER TABLE pr_review.team_settings ADD COLUMN IF NOT EXISTS max_reviewers INT NOT NULL DEFAULT 0 CHECK (max_reviewers >= 0);
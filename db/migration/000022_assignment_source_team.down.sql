@@ -0,0 +1 @@
+ALTER TABLE pr_review.assignment_history DROP COLUMN IF EXISTS team_id;
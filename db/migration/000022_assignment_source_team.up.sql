@@ -0,0 +1,6 @@
+ALTER TABLE pr_review.assignment_history ADD COLUMN IF NOT EXISTS team_id BIGINT REFERENCES pr_review.team(id) ON DELETE SET NULL;
+
+UPDATE pr_review.assignment_history h
+SET team_id = u.team_id
+FROM pr_review.user u
+WHERE u.id = h.reviewer_id AND h.action = 'ASSIGNED' AND h.team_id IS NULL;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS pr_review.review_swap_offer (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id BIGINT NOT NULL REFERENCES pr_review.pull_request(id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(255) NOT NULL,
+    claimer_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (pull_request_id, reviewer_id, claimer_id)
+);
@@ -0,0 +1 @@
+ALTER TABLE pr_review.pull_request ADD COLUMN IF NOT EXISTS changed_files TEXT[] NOT NULL DEFAULT '{}';
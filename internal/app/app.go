@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"io"
+	"math/rand"
 	"net/http"
 	"os"
 	"os/signal"
@@ -39,11 +40,35 @@ func New(configPath string) *App {
 	userRepo := postgres.NewUserRepository(db)
 	pullRequestRepo := postgres.NewPullRequestRepository(db)
 	teamRepo := postgres.NewTeamRepository(db)
+	rotationRepo := postgres.NewTeamRotationRepository(db)
+	teamSettingsRepo := postgres.NewTeamSettingsRepository(db)
+	outOfOfficeRepo := postgres.NewOutOfOfficeRepository(db)
+	codeOwnersRepo := postgres.NewCodeOwnersRepository(db)
+	historyRepo := postgres.NewAssignmentHistoryRepository(db)
+	exclusionRepo := postgres.NewReviewExclusionRepository(db)
+	reviewRepo := postgres.NewReviewRepository(db)
+	swapOfferRepo := postgres.NewReviewSwapOfferRepository(db)
+	transactor := postgres.NewTransactor(db)
 
 	svc, err := service.NewService(&service.Config{
-		UserRepo:        userRepo,
-		PullRequestRepo: pullRequestRepo,
-		TeamRepo:        teamRepo,
+		UserRepo:         userRepo,
+		PullRequestRepo:  pullRequestRepo,
+		TeamRepo:         teamRepo,
+		RotationRepo:     rotationRepo,
+		TeamSettingsRepo: teamSettingsRepo,
+		OutOfOfficeRepo:  outOfOfficeRepo,
+		CodeOwnersRepo:   codeOwnersRepo,
+		HistoryRepo:      historyRepo,
+		ExclusionRepo:    exclusionRepo,
+		ReviewRepo:       reviewRepo,
+		SwapOfferRepo:    swapOfferRepo,
+		Transactor:       transactor,
+		DefaultStrategy:  cfg.Assignment.Strategy,
+		TeamStrategies:   cfg.Assignment.TeamStrategies,
+		MaxOpenReviews:   cfg.Assignment.MaxOpenReviews,
+		PairingWindow:    cfg.Assignment.PairingWindow,
+		RandSource:       randSource(cfg.Assignment.Seed),
+		Deterministic:    cfg.Assignment.Deterministic,
 	})
 
 	if err != nil {
@@ -66,6 +91,13 @@ func New(configPath string) *App {
 	}
 }
 
+func randSource(seed int64) rand.Source {
+	if seed == 0 {
+		return nil
+	}
+	return rand.NewSource(seed)
+}
+
 func newEcho(cfg *config.Config) *echo.Echo {
 	e := echo.New()
 
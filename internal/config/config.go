@@ -44,12 +44,27 @@ type RateLimitConfig struct {
 	Burst    int `yaml:"burst"`
 }
 
+type AssignmentConfig struct {
+	Strategy       string            `yaml:"strategy"`
+	TeamStrategies map[string]string `yaml:"team_strategies"`
+	MaxOpenReviews int               `yaml:"max_open_reviews"`
+	PairingWindow  int               `yaml:"pairing_window"`
+	Deterministic  bool              `yaml:"deterministic"`
+	Seed           int64             `yaml:"seed"`
+}
+
+type AdminConfig struct {
+	Token string `yaml:"token"`
+}
+
 type Config struct {
 	Log             LogConfig        `yaml:"log"`
 	HTTPServer      HTTPServerConfig `yaml:"http_server"`
 	Database        DatabaseConfig   `yaml:"database"`
 	GracefulTimeout time.Duration    `yaml:"graceful_timeout"`
 	RateLimit       RateLimitConfig  `yaml:"rate_limit"`
+	Assignment      AssignmentConfig `yaml:"assignment"`
+	Admin           AdminConfig      `yaml:"admin"`
 }
 
 func ReadConfig(paths ...string) (*Config, error) {
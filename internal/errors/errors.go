@@ -9,8 +9,23 @@ var (
 	NotFoundError      = errors.New("not found")
 	AlreadyExistsError = errors.New("already exists")
 	BusinessLogicError = errors.New("business logic error")
+	ValidationError    = errors.New("validation error")
+	TransitionError    = errors.New("invalid status transition")
 )
 
+type StatusTransitionError struct {
+	Action string
+	Status string
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("%s: cannot %s PR in status %s", TransitionError, e.Action, e.Status)
+}
+
+func (e *StatusTransitionError) Unwrap() error {
+	return TransitionError
+}
+
 func NewBusinessLogicError(msg string) error {
 	return fmt.Errorf("%w: %s", BusinessLogicError, msg)
 }
@@ -22,3 +37,15 @@ func NewNotFoundError(msg string) error {
 func NewAlreadyExistsError(msg string) error {
 	return fmt.Errorf("%w: %s", AlreadyExistsError, msg)
 }
+
+func NewValidationError(msg string) error {
+	return fmt.Errorf("%w: %s", ValidationError, msg)
+}
+
+func IsNotFound(err error) bool {
+	return errors.Is(err, NotFoundError)
+}
+
+func NewTransitionError(action, status string) error {
+	return &StatusTransitionError{Action: action, Status: status}
+}
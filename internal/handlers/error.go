@@ -16,7 +16,17 @@ func ConvertDomainError(c echo.Context, err error, description string) error {
 		return nil
 	}
 
+	var transitionErr *domainerrors.StatusTransitionError
+
 	switch {
+	case errors.As(err, &transitionErr):
+		return c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_TRANSITION",
+				Message: fmt.Sprintf("cannot %s PR in status %s", transitionErr.Action, transitionErr.Status),
+			},
+		})
+
 	case errors.Is(err, domainerrors.NotFoundError):
 		return c.JSON(http.StatusNotFound, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
@@ -51,12 +61,49 @@ func ConvertDomainError(c echo.Context, err error, description string) error {
 	case errors.Is(err, domainerrors.BusinessLogicError):
 		msg := err.Error()
 		var code string
-		if strings.Contains(msg, "cannot reassign on merged PR") {
+		if strings.Contains(msg, "cannot reassign on merged PR") || strings.Contains(msg, "cannot claim review on merged PR") ||
+			strings.Contains(msg, "cannot review merged PR") || strings.Contains(msg, "cannot change author of merged PR") {
 			code = "PR_MERGED"
 		} else if strings.Contains(msg, "reviewer is not assigned") {
 			code = "NOT_ASSIGNED"
 		} else if strings.Contains(msg, "no active replacement candidate") {
 			code = "NO_CANDIDATE"
+		} else if strings.Contains(msg, "not enough reviewer candidates") {
+			code = "NOT_ENOUGH_CANDIDATES"
+		} else if strings.Contains(msg, "no reviewer capacity left") {
+			code = "NO_CAPACITY"
+		} else if strings.Contains(msg, "cannot reassign mandatory reviewer") || strings.Contains(msg, "cannot remove mandatory reviewer") {
+			code = "MANDATORY_REVIEWER"
+		} else if strings.Contains(msg, "senior reviewer required") {
+			code = "SENIOR_REQUIRED"
+		} else if strings.Contains(msg, "new reviewer is not active") {
+			code = "REVIEWER_INACTIVE"
+		} else if strings.Contains(msg, "new reviewer is the PR author") {
+			code = "REVIEWER_IS_AUTHOR"
+		} else if strings.Contains(msg, "new reviewer is excluded") {
+			code = "REVIEWER_EXCLUDED"
+		} else if strings.Contains(msg, "new reviewer is out of office") {
+			code = "REVIEWER_OUT_OF_OFFICE"
+		} else if strings.Contains(msg, "new reviewer has reached the open review limit") {
+			code = "REVIEWER_OVER_CAPACITY"
+		} else if strings.Contains(msg, "new reviewer is already assigned") {
+			code = "ALREADY_ASSIGNED"
+		} else if strings.Contains(msg, "not a member of the PR's reviewer teams") {
+			code = "CROSS_TEAM"
+		} else if strings.Contains(msg, "merge blocked") {
+			code = "MERGE_BLOCKED"
+		} else if strings.Contains(msg, "no free reviewer slot") {
+			code = "NO_FREE_SLOT"
+		} else if strings.Contains(msg, "replaced reviewer has not agreed to the swap") {
+			code = "SWAP_NOT_AGREED"
+		} else if strings.Contains(msg, "PR is not open for review") {
+			code = "PR_NOT_OPEN"
+		} else if strings.Contains(msg, "version conflict") {
+			code = "VERSION_CONFLICT"
+		} else if strings.Contains(msg, "maximum of") && strings.Contains(msg, "reviewers reached") {
+			code = "MAX_REVIEWERS"
+		} else if strings.Contains(msg, "minimum of") && strings.Contains(msg, "reviewers required") {
+			code = "MIN_REVIEWERS"
 		} else {
 			code = "BUSINESS_LOGIC_ERROR"
 		}
@@ -68,6 +115,14 @@ func ConvertDomainError(c echo.Context, err error, description string) error {
 			},
 		})
 
+	case errors.Is(err, domainerrors.ValidationError):
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.ErrorDetail{
+				Code:    "INVALID_ARGUMENT",
+				Message: extractMessage(err),
+			},
+		})
+
 	default:
 		return c.JSON(http.StatusNotFound, dto.ErrorResponse{
 			Error: dto.ErrorDetail{
@@ -86,6 +141,9 @@ func extractMessage(err error) string {
 	if idx := len("already exists: "); len(msg) > idx && msg[:idx] == "already exists: " {
 		return msg[idx:]
 	}
+	if idx := len("validation error: "); len(msg) > idx && msg[:idx] == "validation error: " {
+		return msg[idx:]
+	}
 	if idx := len("not found: "); len(msg) > idx && msg[:idx] == "not found: " {
 		return msg[idx:]
 	}
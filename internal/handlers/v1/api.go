@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"crypto/subtle"
 	"pr-review/internal/config"
 	"pr-review/internal/handlers/v1/dto"
 	"pr-review/internal/models"
@@ -11,19 +12,48 @@ import (
 )
 
 const (
-	versionAPI = ""
+	versionAPI       = ""
+	adminTokenHeader = "X-Admin-Token"
 )
 
 type Service interface {
 	SetUserIsActive(ctx context.Context, userID string, isActive bool) (*models.User, error)
+	SetUserMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*models.User, error)
+	SetUserSeniority(ctx context.Context, userID string, seniority models.Seniority) (*models.User, error)
+	SetUserExpertise(ctx context.Context, userID string, tags []string) (*models.User, error)
+	SetUserReviewWeight(ctx context.Context, userID string, weight float64) (*models.User, error)
+	SetUserWorkingHours(ctx context.Context, userID string, hours models.WorkingHours) (*models.User, error)
 	ListUserReviews(ctx context.Context, reviewerIDStr string) ([]*models.PullRequest, error)
+	AddOutOfOffice(ctx context.Context, period *models.OutOfOffice) (*models.OutOfOffice, error)
+	ListOutOfOffice(ctx context.Context, userID string) ([]*models.OutOfOffice, error)
+	DeleteOutOfOffice(ctx context.Context, id int64) (*models.OutOfOffice, error)
+	AddReviewExclusion(ctx context.Context, exclusion *models.ReviewExclusion) (*models.ReviewExclusion, error)
+	ListReviewExclusions(ctx context.Context, userID string) ([]*models.ReviewExclusion, error)
+	DeleteReviewExclusion(ctx context.Context, id int64) (*models.ReviewExclusion, error)
 	CreateTeamWithMembers(ctx context.Context, teamName string, members []dto.TeamMember) (*models.Team, []*models.User, error)
 	GetTeamByName(ctx context.Context, teamName string) (*models.Team, []*models.User, error)
 	GetTeamByID(ctx context.Context, teamID int64) (*models.Team, error)
 	DeactivateTeamAndReassign(ctx context.Context, teamName string) (int, error)
-	CreatePullRequest(ctx context.Context, prID, title, authorIDStr string) (*models.PullRequest, error)
-	MergePullRequest(ctx context.Context, prID string) (*models.PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID, oldUserIDStr string) (*models.PullRequest, string, error)
+	GetTeamSettings(ctx context.Context, teamName string) (*models.Team, *models.TeamSettings, error)
+	SetTeamSettings(ctx context.Context, teamName string, update models.TeamSettingsUpdate) (*models.Team, *models.TeamSettings, error)
+	DeleteTeamSettings(ctx context.Context, teamName string) (*models.Team, *models.TeamSettings, error)
+	UploadCodeOwners(ctx context.Context, teamName, content string) (*models.Team, *models.CodeOwners, error)
+	GetCodeOwners(ctx context.Context, teamName string, version int) (*models.Team, *models.CodeOwners, error)
+	GetTeamPairings(ctx context.Context, teamName string) (*models.Team, []models.PairingStat, error)
+	CreatePullRequest(ctx context.Context, in models.PullRequestCreate) (*models.PullRequest, error)
+	PreviewReviewers(ctx context.Context, in models.PullRequestCreate) (*models.PullRequest, []models.CandidateExplanation, error)
+	GetAssignmentHistory(ctx context.Context, prID string) (*models.PullRequest, []*models.AssignmentEvent, error)
+	UpdatePullRequest(ctx context.Context, in models.PullRequestEdit) (*models.PullRequest, error)
+	MergePullRequest(ctx context.Context, in models.PullRequestMerge) (*models.PullRequest, error)
+	MarkPullRequestReady(ctx context.Context, prID string) (*models.PullRequest, error)
+	ClosePullRequest(ctx context.Context, prID string) (*models.PullRequest, error)
+	ReopenPullRequest(ctx context.Context, prID string) (*models.PullRequest, error)
+	ReassignReviewer(ctx context.Context, in models.PullRequestReassign) (*models.PullRequest, string, error)
+	ClaimReview(ctx context.Context, in models.PullRequestClaim) (*models.PullRequest, error)
+	OfferReviewSwap(ctx context.Context, in models.ReviewSwapOfferCreate) (*models.PullRequest, *models.ReviewSwapOffer, error)
+	AddReviewer(ctx context.Context, in models.PullRequestReviewerChange) (*models.PullRequest, error)
+	RemoveReviewer(ctx context.Context, in models.PullRequestReviewerChange) (*models.PullRequest, error)
+	SubmitReview(ctx context.Context, in models.ReviewCreate) (*models.PullRequest, *models.Review, error)
 	GetPullRequestByStringID(ctx context.Context, prID string) (*models.PullRequest, error)
 	GetStats(ctx context.Context) (*models.Stats, error)
 }
@@ -43,6 +73,14 @@ func NewHandlers(cfg APIConfig) *API {
 	}
 }
 
+func (a *API) isAdmin(c echo.Context) bool {
+	token := a.cfg.Admin.Token
+	if token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(c.Request().Header.Get(adminTokenHeader)), []byte(token)) == 1
+}
+
 func (a *API) RegisterHandlers(g *echo.Group) {
 	api := g.Group(versionAPI)
 
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"net/http"
+	"pr-review/internal/handlers"
+	"pr-review/internal/handlers/v1/dto"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (a *API) uploadCodeOwners(c echo.Context) error {
+	var req dto.UploadCodeOwnersRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	team, codeOwners, err := a.service.UploadCodeOwners(ctx, req.TeamName, req.Content)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "upload codeowners")
+	}
+
+	return c.JSON(http.StatusCreated, map[string]any{
+		"codeowners": dto.FromModelCodeOwners(team.Name, codeOwners),
+	})
+}
+
+func (a *API) getCodeOwners(c echo.Context) error {
+	teamName := c.QueryParam("team_name")
+	if teamName == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "team_name is required")
+	}
+
+	version := 0
+	if v := c.QueryParam("version"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "version must be a positive integer")
+		}
+		version = parsed
+	}
+
+	ctx := c.Request().Context()
+	team, codeOwners, err := a.service.GetCodeOwners(ctx, teamName, version)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "get codeowners")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"codeowners": dto.FromModelCodeOwners(team.Name, codeOwners),
+	})
+}
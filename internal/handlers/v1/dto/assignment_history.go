@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"time"
+
+	"pr-review/internal/models"
+)
+
+type CandidateExplanation struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
+	Selected bool   `json:"selected"`
+	Reason   string `json:"reason"`
+}
+
+type ReviewerPreviewResponse struct {
+	Status            string                 `json:"status"`
+	AssignedReviewers []string               `json:"assigned_reviewers"`
+	Candidates        []CandidateExplanation `json:"candidates"`
+}
+
+type AssignmentEvent struct {
+	ReviewerID string     `json:"reviewer_id"`
+	Action     string     `json:"action"`
+	Reason     string     `json:"reason"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+}
+
+type AssignmentHistoryResponse struct {
+	PullRequestID string            `json:"pull_request_id"`
+	Events        []AssignmentEvent `json:"events"`
+}
+
+func FromModelReviewerPreview(pr *models.PullRequest, candidates []models.CandidateExplanation) ReviewerPreviewResponse {
+	reviewers := append([]string{}, pr.Reviewers...)
+
+	out := make([]CandidateExplanation, 0, len(candidates))
+	for _, c := range candidates {
+		out = append(out, CandidateExplanation{
+			UserID:   c.UserID,
+			TeamName: c.TeamName,
+			Selected: c.Selected,
+			Reason:   string(c.Reason),
+		})
+	}
+
+	return ReviewerPreviewResponse{
+		Status:            string(pr.Status),
+		AssignedReviewers: reviewers,
+		Candidates:        out,
+	}
+}
+
+func FromModelAssignmentHistory(pr *models.PullRequest, events []*models.AssignmentEvent) AssignmentHistoryResponse {
+	out := make([]AssignmentEvent, 0, len(events))
+	for _, e := range events {
+		out = append(out, AssignmentEvent{
+			ReviewerID: e.ReviewerID,
+			Action:     string(e.Action),
+			Reason:     string(e.Reason),
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+
+	return AssignmentHistoryResponse{
+		PullRequestID: pr.PullRequestID,
+		Events:        out,
+	}
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"pr-review/internal/models"
+)
+
+type UploadCodeOwnersRequest struct {
+	TeamName string `json:"team_name" validate:"required"`
+	Content  string `json:"content" validate:"required"`
+}
+
+type CodeOwnersResponse struct {
+	TeamName  string     `json:"team_name"`
+	Version   int        `json:"version"`
+	Content   string     `json:"content"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
+
+func FromModelCodeOwners(teamName string, c *models.CodeOwners) CodeOwnersResponse {
+	return CodeOwnersResponse{
+		TeamName:  teamName,
+		Version:   c.Version,
+		Content:   c.Content,
+		CreatedAt: c.CreatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"time"
+
+	"pr-review/internal/models"
+)
+
+type AddOutOfOfficeRequest struct {
+	UserID   string    `json:"user_id" validate:"required"`
+	StartsAt time.Time `json:"starts_at" validate:"required"`
+	EndsAt   time.Time `json:"ends_at" validate:"required"`
+	Reason   string    `json:"reason"`
+}
+
+type DeleteOutOfOfficeRequest struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+type OutOfOfficeResponse struct {
+	ID       int64     `json:"id"`
+	UserID   string    `json:"user_id"`
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   string    `json:"reason"`
+	IsActive bool      `json:"is_active"`
+}
+
+type ListOutOfOfficeResponse struct {
+	UserID  string                `json:"user_id"`
+	Periods []OutOfOfficeResponse `json:"periods"`
+}
+
+func (r AddOutOfOfficeRequest) ToModel() *models.OutOfOffice {
+	return &models.OutOfOffice{
+		UserID:   r.UserID,
+		StartsAt: r.StartsAt,
+		EndsAt:   r.EndsAt,
+		Reason:   r.Reason,
+	}
+}
+
+func FromModelOutOfOffice(o *models.OutOfOffice) OutOfOfficeResponse {
+	return OutOfOfficeResponse{
+		ID:       o.ID,
+		UserID:   o.UserID,
+		StartsAt: o.StartsAt,
+		EndsAt:   o.EndsAt,
+		Reason:   o.Reason,
+		IsActive: o.IsActive,
+	}
+}
+
+func FromModelOutOfOfficeList(periods []*models.OutOfOffice) []OutOfOfficeResponse {
+	out := make([]OutOfOfficeResponse, 0, len(periods))
+	for _, p := range periods {
+		if p == nil {
+			continue
+		}
+		out = append(out, FromModelOutOfOffice(p))
+	}
+
+	return out
+}
@@ -0,0 +1,23 @@
+package dto
+
+import "pr-review/internal/models"
+
+type PairingMatrixResponse struct {
+	TeamName string                      `json:"team_name"`
+	Matrix   map[string]map[string]int64 `json:"matrix"`
+}
+
+func FromModelPairings(teamName string, pairings []models.PairingStat) PairingMatrixResponse {
+	matrix := make(map[string]map[string]int64)
+	for _, p := range pairings {
+		if matrix[p.AuthorID] == nil {
+			matrix[p.AuthorID] = make(map[string]int64)
+		}
+		matrix[p.AuthorID][p.ReviewerID] = p.Reviews
+	}
+
+	return PairingMatrixResponse{
+		TeamName: teamName,
+		Matrix:   matrix,
+	}
+}
@@ -7,13 +7,32 @@ import (
 )
 
 type CreatePullRequestRequest struct {
-	PullRequestID   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorID        string `json:"author_id"`
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	ChangedFiles    []string `json:"changed_files"`
+	Labels          []string `json:"labels"`
+	LinesAdded      *int     `json:"lines_added"`
+	LinesRemoved    *int     `json:"lines_removed"`
+	FilesChanged    *int     `json:"files_changed"`
+	Draft           bool     `json:"draft"`
+}
+
+type PullRequestStatusRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required"`
+}
+
+type UpdatePullRequestRequest struct {
+	PullRequestID    string  `json:"pull_request_id" validate:"required"`
+	NewPullRequestID *string `json:"new_pull_request_id"`
+	PullRequestName  *string `json:"pull_request_name"`
+	AuthorID         *string `json:"author_id"`
+	Version          int64   `json:"version" validate:"required"`
 }
 
 type MergePullRequestRequest struct {
 	PullRequestID string `json:"pull_request_id"`
+	Force         bool   `json:"force"`
 }
 
 type ReassignReviewerRequest struct {
@@ -22,18 +41,57 @@ type ReassignReviewerRequest struct {
 }
 
 type PullRequestResponse struct {
-	PullRequestID     string     `json:"pull_request_id"`
-	PullRequestName   string     `json:"pull_request_name"`
-	AuthorID          string     `json:"author_id"`
-	Status            string     `json:"status"`
-	AssignedReviewers []string   `json:"assigned_reviewers"`
-	CreatedAt         *time.Time `json:"createdAt,omitempty"`
-	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+	PullRequestID     string           `json:"pull_request_id"`
+	PullRequestName   string           `json:"pull_request_name"`
+	AuthorID          string           `json:"author_id"`
+	Status            string           `json:"status"`
+	AssignedReviewers []string         `json:"assigned_reviewers"`
+	Labels            []string         `json:"labels,omitempty"`
+	ReviewerSources   []ReviewerSource `json:"reviewer_sources,omitempty"`
+	Size              *PullRequestSize `json:"size,omitempty"`
+	Reviews           []ReviewResponse `json:"reviews,omitempty"`
+	ForcedMerge       bool             `json:"forced_merge,omitempty"`
+	MergeBlockers     []string         `json:"merge_blockers,omitempty"`
+	Version           int64            `json:"version"`
+	CreatedAt         *time.Time       `json:"createdAt,omitempty"`
+	MergedAt          *time.Time       `json:"mergedAt,omitempty"`
+}
+
+type ReviewerSource struct {
+	UserID     string `json:"user_id"`
+	TeamName   string `json:"team_name"`
+	MatchScore int    `json:"match_score"`
+}
+
+type PullRequestSize struct {
+	LinesAdded   *int `json:"lines_added,omitempty"`
+	LinesRemoved *int `json:"lines_removed,omitempty"`
+	FilesChanged *int `json:"files_changed,omitempty"`
 }
 
 type ReassignPullRequestRequest struct {
-	PullRequestID string `json:"pull_request_id"`
-	OldUserID     string `json:"old_user_id"`
+	PullRequestID  string `json:"pull_request_id"`
+	OldUserID      string `json:"old_user_id"`
+	NewUserID      string `json:"new_user_id"`
+	AllowCrossTeam bool   `json:"allow_cross_team"`
+}
+
+type ClaimReviewRequest struct {
+	PullRequestID  string `json:"pull_request_id" validate:"required"`
+	UserID         string `json:"user_id" validate:"required"`
+	ReplaceUserID  string `json:"replace_user_id"`
+	AllowCrossTeam bool   `json:"allow_cross_team"`
+}
+
+type AddReviewerRequest struct {
+	PullRequestID  string `json:"pull_request_id" validate:"required"`
+	UserID         string `json:"user_id" validate:"required"`
+	AllowCrossTeam bool   `json:"allow_cross_team"`
+}
+
+type RemoveReviewerRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required"`
+	UserID        string `json:"user_id" validate:"required"`
 }
 
 type ReassignPullRequestResponse struct {
@@ -41,34 +99,134 @@ type ReassignPullRequestResponse struct {
 	ReplacedBy string              `json:"replaced_by"`
 }
 
+func (r CreatePullRequestRequest) ToModel() models.PullRequestCreate {
+	return models.PullRequestCreate{
+		PullRequestID: r.PullRequestID,
+		Title:         r.PullRequestName,
+		AuthorID:      r.AuthorID,
+		ChangedFiles:  r.ChangedFiles,
+		Labels:        r.Labels,
+		Size: models.PullRequestSize{
+			LinesAdded:   r.LinesAdded,
+			LinesRemoved: r.LinesRemoved,
+			FilesChanged: r.FilesChanged,
+		},
+		Draft: r.Draft,
+	}
+}
+
+func (r ReassignPullRequestRequest) ToModel() models.PullRequestReassign {
+	return models.PullRequestReassign{
+		PullRequestID:  r.PullRequestID,
+		OldUserID:      r.OldUserID,
+		NewUserID:      r.NewUserID,
+		AllowCrossTeam: r.AllowCrossTeam,
+	}
+}
+
+func (r UpdatePullRequestRequest) ToModel() models.PullRequestEdit {
+	return models.PullRequestEdit{
+		PullRequestID:    r.PullRequestID,
+		NewPullRequestID: r.NewPullRequestID,
+		Title:            r.PullRequestName,
+		AuthorID:         r.AuthorID,
+		Version:          r.Version,
+	}
+}
+
+func (r MergePullRequestRequest) ToModel() models.PullRequestMerge {
+	return models.PullRequestMerge{
+		PullRequestID: r.PullRequestID,
+		Force:         r.Force,
+	}
+}
+
+func (r ClaimReviewRequest) ToModel() models.PullRequestClaim {
+	return models.PullRequestClaim{
+		PullRequestID:  r.PullRequestID,
+		UserID:         r.UserID,
+		ReplaceUserID:  r.ReplaceUserID,
+		AllowCrossTeam: r.AllowCrossTeam,
+	}
+}
+
+func (r AddReviewerRequest) ToModel() models.PullRequestReviewerChange {
+	return models.PullRequestReviewerChange{
+		PullRequestID:  r.PullRequestID,
+		UserID:         r.UserID,
+		AllowCrossTeam: r.AllowCrossTeam,
+	}
+}
+
+func (r RemoveReviewerRequest) ToModel() models.PullRequestReviewerChange {
+	return models.PullRequestReviewerChange{
+		PullRequestID: r.PullRequestID,
+		UserID:        r.UserID,
+	}
+}
+
 func FromModelPullRequest(pr *models.PullRequest) PullRequestResponse {
 	reviewers := append([]string(nil), pr.Reviewers...)
 	if reviewers == nil {
 		reviewers = []string{}
 	}
 
+	var sources []ReviewerSource
+	for _, src := range pr.ReviewerSources {
+		sources = append(sources, ReviewerSource{
+			UserID:     src.UserID,
+			TeamName:   src.TeamName,
+			MatchScore: src.MatchScore,
+		})
+	}
+
+	var size *PullRequestSize
+	if pr.PullRequestSize.IsKnown() {
+		size = &PullRequestSize{
+			LinesAdded:   pr.LinesAdded,
+			LinesRemoved: pr.LinesRemoved,
+			FilesChanged: pr.FilesChanged,
+		}
+	}
+
 	return PullRequestResponse{
 		PullRequestID:     pr.PullRequestID,
 		PullRequestName:   pr.Title,
 		AuthorID:          pr.AuthorID,
 		Status:            string(pr.Status),
 		AssignedReviewers: reviewers,
+		Labels:            pr.Labels,
+		ReviewerSources:   sources,
+		Size:              size,
+		Reviews:           FromModelReviewList(pr.Reviews),
+		ForcedMerge:       pr.ForcedMerge,
+		MergeBlockers:     pr.MergeBlockers,
+		Version:           pr.Version,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
 	}
 }
 
-func FromModelPullRequestShortList(prs []*models.PullRequest) []PullRequestShortResponse {
+func FromModelPullRequestShortList(reviewerID string, prs []*models.PullRequest) []PullRequestShortResponse {
 	out := make([]PullRequestShortResponse, 0, len(prs))
 	for _, pr := range prs {
 		if pr == nil {
 			continue
 		}
+
+		var verdict string
+		for _, r := range pr.Reviews {
+			if r.ReviewerID == reviewerID {
+				verdict = string(r.Verdict)
+			}
+		}
+
 		out = append(out, PullRequestShortResponse{
 			PullRequestID:   pr.PullRequestID,
 			PullRequestName: pr.Title,
 			AuthorID:        pr.AuthorID,
 			Status:          string(pr.Status),
+			Verdict:         verdict,
 		})
 	}
 
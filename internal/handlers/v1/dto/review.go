@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"pr-review/internal/models"
+)
+
+type SubmitReviewRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required"`
+	ReviewerID    string `json:"reviewer_id" validate:"required"`
+	Verdict       string `json:"verdict" validate:"required"`
+	Body          string `json:"body"`
+}
+
+type ReviewResponse struct {
+	ReviewerID string     `json:"reviewer_id"`
+	Verdict    string     `json:"verdict"`
+	Body       string     `json:"body,omitempty"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+}
+
+func (r SubmitReviewRequest) ToModel() models.ReviewCreate {
+	return models.ReviewCreate{
+		PullRequestID: r.PullRequestID,
+		ReviewerID:    r.ReviewerID,
+		Verdict:       models.ReviewVerdict(r.Verdict),
+		Body:          r.Body,
+	}
+}
+
+func FromModelReview(r *models.Review) ReviewResponse {
+	return ReviewResponse{
+		ReviewerID: r.ReviewerID,
+		Verdict:    string(r.Verdict),
+		Body:       r.Body,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func FromModelReviewList(reviews []models.Review) []ReviewResponse {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	out := make([]ReviewResponse, 0, len(reviews))
+	for i := range reviews {
+		out = append(out, FromModelReview(&reviews[i]))
+	}
+
+	return out
+}
@@ -0,0 +1,63 @@
+package dto
+
+import "pr-review/internal/models"
+
+type AddReviewExclusionRequest struct {
+	ReviewerID string `json:"reviewer_id" validate:"required"`
+	AuthorID   string `json:"author_id" validate:"required"`
+	Symmetric  *bool  `json:"symmetric"`
+	Reason     string `json:"reason"`
+}
+
+type DeleteReviewExclusionRequest struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+type ReviewExclusionResponse struct {
+	ID         int64  `json:"id"`
+	ReviewerID string `json:"reviewer_id"`
+	AuthorID   string `json:"author_id"`
+	Symmetric  bool   `json:"symmetric"`
+	Reason     string `json:"reason"`
+}
+
+type ListReviewExclusionsResponse struct {
+	UserID     string                    `json:"user_id"`
+	Exclusions []ReviewExclusionResponse `json:"exclusions"`
+}
+
+func (r AddReviewExclusionRequest) ToModel() *models.ReviewExclusion {
+	symmetric := true
+	if r.Symmetric != nil {
+		symmetric = *r.Symmetric
+	}
+
+	return &models.ReviewExclusion{
+		ReviewerID: r.ReviewerID,
+		AuthorID:   r.AuthorID,
+		Symmetric:  symmetric,
+		Reason:     r.Reason,
+	}
+}
+
+func FromModelReviewExclusion(e *models.ReviewExclusion) ReviewExclusionResponse {
+	return ReviewExclusionResponse{
+		ID:         e.ID,
+		ReviewerID: e.ReviewerID,
+		AuthorID:   e.AuthorID,
+		Symmetric:  e.Symmetric,
+		Reason:     e.Reason,
+	}
+}
+
+func FromModelReviewExclusionList(exclusions []*models.ReviewExclusion) []ReviewExclusionResponse {
+	out := make([]ReviewExclusionResponse, 0, len(exclusions))
+	for _, e := range exclusions {
+		if e == nil {
+			continue
+		}
+		out = append(out, FromModelReviewExclusion(e))
+	}
+
+	return out
+}
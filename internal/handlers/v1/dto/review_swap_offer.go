@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"pr-review/internal/models"
+)
+
+type OfferReviewSwapRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required"`
+	UserID        string `json:"user_id" validate:"required"`
+	ClaimerID     string `json:"claimer_id" validate:"required"`
+}
+
+type ReviewSwapOfferResponse struct {
+	PullRequestID string     `json:"pull_request_id"`
+	UserID        string     `json:"user_id"`
+	ClaimerID     string     `json:"claimer_id"`
+	CreatedAt     *time.Time `json:"createdAt,omitempty"`
+}
+
+func (r OfferReviewSwapRequest) ToModel() models.ReviewSwapOfferCreate {
+	return models.ReviewSwapOfferCreate{
+		PullRequestID: r.PullRequestID,
+		ReviewerID:    r.UserID,
+		ClaimerID:     r.ClaimerID,
+	}
+}
+
+func FromModelReviewSwapOffer(pr *models.PullRequest, o *models.ReviewSwapOffer) ReviewSwapOfferResponse {
+	return ReviewSwapOfferResponse{
+		PullRequestID: pr.PullRequestID,
+		UserID:        o.ReviewerID,
+		ClaimerID:     o.ClaimerID,
+		CreatedAt:     o.CreatedAt,
+	}
+}
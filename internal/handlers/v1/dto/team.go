@@ -1,6 +1,10 @@
 package dto
 
-import "pr-review/internal/models"
+import (
+	"time"
+
+	"pr-review/internal/models"
+)
 
 type AddTeamRequest struct {
 	TeamName string       `json:"team_name"`
@@ -8,9 +12,19 @@ type AddTeamRequest struct {
 }
 
 type TeamMember struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	IsActive bool   `json:"is_active"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	IsActive  bool   `json:"is_active"`
+	Seniority string `json:"seniority,omitempty"`
+}
+
+type TeamMemberResponse struct {
+	UserID           string     `json:"user_id"`
+	Username         string     `json:"username"`
+	IsActive         bool       `json:"is_active"`
+	Seniority        string     `json:"seniority,omitempty"`
+	IsAvailable      bool       `json:"is_available"`
+	OutOfOfficeUntil *time.Time `json:"out_of_office_until,omitempty"`
 }
 
 type GetTeamRequest struct {
@@ -23,8 +37,8 @@ type CreateTeamRequest struct {
 }
 
 type TeamResponse struct {
-	TeamName string       `json:"team_name"`
-	Members  []TeamMember `json:"members"`
+	TeamName string               `json:"team_name"`
+	Members  []TeamMemberResponse `json:"members"`
 }
 
 type DeactivateTeamRequest struct {
@@ -36,19 +50,22 @@ type DeactivateTeamResponse struct {
 	ReassignedPRsCnt int    `json:"reassigned_prs_count"`
 }
 
-func ToTeamMembers(users []*models.User) []TeamMember {
+func ToTeamMembers(users []*models.User) []TeamMemberResponse {
 	if len(users) == 0 {
-		return []TeamMember{}
+		return []TeamMemberResponse{}
 	}
-	out := make([]TeamMember, 0, len(users))
+	out := make([]TeamMemberResponse, 0, len(users))
 	for _, u := range users {
 		if u == nil {
 			continue
 		}
-		out = append(out, TeamMember{
-			UserID:   u.ID,
-			Username: u.Name,
-			IsActive: u.IsActive,
+		out = append(out, TeamMemberResponse{
+			UserID:           u.ID,
+			Username:         u.Name,
+			IsActive:         u.IsActive,
+			Seniority:        string(u.Seniority),
+			IsAvailable:      u.IsAvailable(),
+			OutOfOfficeUntil: u.OutOfOfficeUntil,
 		})
 	}
 
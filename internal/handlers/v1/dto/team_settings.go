@@ -0,0 +1,125 @@
+package dto
+
+import "pr-review/internal/models"
+
+type SetTeamSettingsRequest struct {
+	TeamName       string    `json:"team_name" validate:"required"`
+	ReviewersCount *int      `json:"reviewers_count"`
+	MinApprovals   *int      `json:"min_approvals"`
+	Strategy       *string   `json:"strategy"`
+	Fallback       *string   `json:"fallback"`
+	FallbackTeams  *[]string `json:"fallback_teams"`
+
+	MandatoryReviewers *[]string   `json:"mandatory_reviewers"`
+	RequireSenior      *bool       `json:"require_senior"`
+	PreferWorkingHours *bool       `json:"prefer_working_hours"`
+	SizeRules          *[]SizeRule `json:"size_rules"`
+
+	BlockOnChangesRequested  *bool `json:"block_on_changes_requested"`
+	RequireMandatoryApproval *bool `json:"require_mandatory_approval"`
+
+	MinReviewers *int `json:"min_reviewers"`
+	MaxReviewers *int `json:"max_reviewers"`
+}
+
+type SizeRule struct {
+	MaxLines       int `json:"max_lines"`
+	MaxFiles       int `json:"max_files"`
+	ReviewersCount int `json:"reviewers_count"`
+}
+
+type DeleteTeamSettingsRequest struct {
+	TeamName string `json:"team_name" validate:"required"`
+}
+
+type TeamSettingsResponse struct {
+	TeamName       string   `json:"team_name"`
+	ReviewersCount int      `json:"reviewers_count"`
+	MinApprovals   int      `json:"min_approvals"`
+	Strategy       string   `json:"strategy"`
+	Fallback       string   `json:"fallback"`
+	FallbackTeams  []string `json:"fallback_teams"`
+
+	MandatoryReviewers []string   `json:"mandatory_reviewers"`
+	RequireSenior      bool       `json:"require_senior"`
+	PreferWorkingHours bool       `json:"prefer_working_hours"`
+	SizeRules          []SizeRule `json:"size_rules"`
+
+	BlockOnChangesRequested  bool `json:"block_on_changes_requested"`
+	RequireMandatoryApproval bool `json:"require_mandatory_approval"`
+
+	MinReviewers int `json:"min_reviewers"`
+	MaxReviewers int `json:"max_reviewers"`
+}
+
+func (r SetTeamSettingsRequest) ToModelUpdate() models.TeamSettingsUpdate {
+	update := models.TeamSettingsUpdate{
+		ReviewersCount: r.ReviewersCount,
+		MinApprovals:   r.MinApprovals,
+		Strategy:       r.Strategy,
+		FallbackTeams:  r.FallbackTeams,
+
+		MandatoryReviewers: r.MandatoryReviewers,
+		RequireSenior:      r.RequireSenior,
+		PreferWorkingHours: r.PreferWorkingHours,
+
+		BlockOnChangesRequested:  r.BlockOnChangesRequested,
+		RequireMandatoryApproval: r.RequireMandatoryApproval,
+
+		MinReviewers: r.MinReviewers,
+		MaxReviewers: r.MaxReviewers,
+	}
+	if r.SizeRules != nil {
+		rules := make([]models.SizeRule, 0, len(*r.SizeRules))
+		for _, rule := range *r.SizeRules {
+			rules = append(rules, models.SizeRule{
+				MaxLines:       rule.MaxLines,
+				MaxFiles:       rule.MaxFiles,
+				ReviewersCount: rule.ReviewersCount,
+			})
+		}
+		update.SizeRules = &rules
+	}
+	if r.Fallback != nil {
+		fallback := models.AssignmentFallback(*r.Fallback)
+		update.Fallback = &fallback
+	}
+
+	return update
+}
+
+func FromModelTeamSettings(teamName string, s *models.TeamSettings) TeamSettingsResponse {
+	fallbackTeams := make([]string, 0, len(s.FallbackTeams))
+	for _, t := range s.FallbackTeams {
+		fallbackTeams = append(fallbackTeams, t.Name)
+	}
+
+	sizeRules := make([]SizeRule, 0, len(s.SizeRules))
+	for _, rule := range s.SizeRules {
+		sizeRules = append(sizeRules, SizeRule{
+			MaxLines:       rule.MaxLines,
+			MaxFiles:       rule.MaxFiles,
+			ReviewersCount: rule.ReviewersCount,
+		})
+	}
+
+	return TeamSettingsResponse{
+		TeamName:       teamName,
+		ReviewersCount: s.ReviewersCount,
+		MinApprovals:   s.MinApprovals,
+		Strategy:       s.Strategy,
+		Fallback:       string(s.Fallback),
+		FallbackTeams:  fallbackTeams,
+
+		MandatoryReviewers: append([]string{}, s.MandatoryReviewers...),
+		RequireSenior:      s.RequireSenior,
+		PreferWorkingHours: s.PreferWorkingHours,
+		SizeRules:          sizeRules,
+
+		BlockOnChangesRequested:  s.BlockOnChangesRequested,
+		RequireMandatoryApproval: s.RequireMandatoryApproval,
+
+		MinReviewers: s.MinReviewers,
+		MaxReviewers: s.MaxReviewers,
+	}
+}
@@ -7,11 +7,53 @@ type SetIsActiveRequest struct {
 	IsActive bool   `json:"is_active"`
 }
 
+type SetMaxOpenReviewsRequest struct {
+	UserID         string `json:"user_id" validate:"required"`
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+}
+
+type SetSeniorityRequest struct {
+	UserID    string `json:"user_id" validate:"required"`
+	Seniority string `json:"seniority" validate:"required"`
+}
+
+type SetExpertiseRequest struct {
+	UserID    string   `json:"user_id" validate:"required"`
+	Expertise []string `json:"expertise"`
+}
+
+type SetWorkingHoursRequest struct {
+	UserID    string  `json:"user_id" validate:"required"`
+	TimeZone  string  `json:"time_zone"`
+	WorkStart *string `json:"work_start"`
+	WorkEnd   *string `json:"work_end"`
+}
+
+type SetReviewWeightRequest struct {
+	UserID       string  `json:"user_id" validate:"required"`
+	ReviewWeight float64 `json:"review_weight"`
+}
+
 type UserResponse struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	UserID         string   `json:"user_id"`
+	Username       string   `json:"username"`
+	TeamName       string   `json:"team_name"`
+	IsActive       bool     `json:"is_active"`
+	Seniority      string   `json:"seniority"`
+	MaxOpenReviews *int     `json:"max_open_reviews,omitempty"`
+	Expertise      []string `json:"expertise,omitempty"`
+	TimeZone       string   `json:"time_zone,omitempty"`
+	WorkStart      *string  `json:"work_start,omitempty"`
+	WorkEnd        *string  `json:"work_end,omitempty"`
+	ReviewWeight   float64  `json:"review_weight"`
+}
+
+func (r SetWorkingHoursRequest) ToModel() models.WorkingHours {
+	return models.WorkingHours{
+		TimeZone: r.TimeZone,
+		Start:    r.WorkStart,
+		End:      r.WorkEnd,
+	}
 }
 
 type PullRequestShortResponse struct {
@@ -19,6 +61,7 @@ type PullRequestShortResponse struct {
 	PullRequestName string `json:"pull_request_name"`
 	AuthorID        string `json:"author_id"`
 	Status          string `json:"status"`
+	Verdict         string `json:"verdict,omitempty"`
 }
 
 type GetReviewResponse struct {
@@ -28,9 +71,16 @@ type GetReviewResponse struct {
 
 func FromModelUser(u *models.User, teamName string) UserResponse {
 	return UserResponse{
-		UserID:   u.ID,
-		Username: u.Name,
-		TeamName: teamName,
-		IsActive: u.IsActive,
+		UserID:         u.ID,
+		Username:       u.Name,
+		TeamName:       teamName,
+		IsActive:       u.IsActive,
+		Seniority:      string(u.Seniority),
+		MaxOpenReviews: u.MaxOpenReviews,
+		Expertise:      u.Expertise,
+		TimeZone:       u.TimeZone,
+		WorkStart:      u.WorkStart,
+		WorkEnd:        u.WorkEnd,
+		ReviewWeight:   u.ReviewWeight,
 	}
 }
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"net/http"
+	"pr-review/internal/handlers"
+	"pr-review/internal/handlers/v1/dto"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (a *API) addOutOfOffice(c echo.Context) error {
+	var req dto.AddOutOfOfficeRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	period, err := a.service.AddOutOfOffice(ctx, req.ToModel())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "add out of office")
+	}
+
+	return c.JSON(http.StatusCreated, map[string]any{
+		"period": dto.FromModelOutOfOffice(period),
+	})
+}
+
+func (a *API) listOutOfOffice(c echo.Context) error {
+	userID := c.QueryParam("user_id")
+	if userID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "user_id is required")
+	}
+
+	ctx := c.Request().Context()
+	periods, err := a.service.ListOutOfOffice(ctx, userID)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "list out of office")
+	}
+
+	return c.JSON(http.StatusOK, dto.ListOutOfOfficeResponse{
+		UserID:  userID,
+		Periods: dto.FromModelOutOfOfficeList(periods),
+	})
+}
+
+func (a *API) deleteOutOfOffice(c echo.Context) error {
+	var req dto.DeleteOutOfOfficeRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	period, err := a.service.DeleteOutOfOffice(ctx, req.ID)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "delete out of office")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"period": dto.FromModelOutOfOffice(period),
+	})
+}
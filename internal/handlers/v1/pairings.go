@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"net/http"
+	"pr-review/internal/handlers"
+	"pr-review/internal/handlers/v1/dto"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (a *API) getTeamPairings(c echo.Context) error {
+	teamName := c.QueryParam("team_name")
+	if teamName == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "team_name is required")
+	}
+
+	ctx := c.Request().Context()
+	team, pairings, err := a.service.GetTeamPairings(ctx, teamName)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "get team pairings")
+	}
+
+	return c.JSON(http.StatusOK, dto.FromModelPairings(team.Name, pairings))
+}
@@ -1,9 +1,11 @@
 package v1
 
 import (
+	"context"
 	"net/http"
 	"pr-review/internal/handlers"
 	"pr-review/internal/handlers/v1/dto"
+	"pr-review/internal/models"
 
 	"github.com/labstack/echo/v4"
 )
@@ -19,7 +21,7 @@ func (a *API) createPullRequest(c echo.Context) error {
 	}
 
 	ctx := c.Request().Context()
-	pr, err := a.service.CreatePullRequest(ctx, req.PullRequestID, req.PullRequestName, req.AuthorID)
+	pr, err := a.service.CreatePullRequest(ctx, req.ToModel())
 	if err != nil {
 		return handlers.ConvertDomainError(c, err, "create pull request")
 	}
@@ -29,6 +31,61 @@ func (a *API) createPullRequest(c echo.Context) error {
 	})
 }
 
+func (a *API) previewReviewers(c echo.Context) error {
+	var req dto.CreatePullRequestRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	pr, candidates, err := a.service.PreviewReviewers(ctx, req.ToModel())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "preview reviewers")
+	}
+
+	return c.JSON(http.StatusOK, dto.FromModelReviewerPreview(pr, candidates))
+}
+
+func (a *API) getAssignmentHistory(c echo.Context) error {
+	prID := c.QueryParam("pull_request_id")
+	if prID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "pull_request_id is required")
+	}
+
+	ctx := c.Request().Context()
+	pr, events, err := a.service.GetAssignmentHistory(ctx, prID)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "get assignment history")
+	}
+
+	return c.JSON(http.StatusOK, dto.FromModelAssignmentHistory(pr, events))
+}
+
+func (a *API) updatePullRequest(c echo.Context) error {
+	var req dto.UpdatePullRequestRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	pr, err := a.service.UpdatePullRequest(ctx, req.ToModel())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "update pull request")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"pr": dto.FromModelPullRequest(pr),
+	})
+}
+
 func (a *API) mergePullRequest(c echo.Context) error {
 	var req dto.MergePullRequestRequest
 
@@ -39,8 +96,12 @@ func (a *API) mergePullRequest(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
+	if req.Force && !a.isAdmin(c) {
+		return echo.NewHTTPError(http.StatusForbidden, "force merge requires admin token")
+	}
+
 	ctx := c.Request().Context()
-	pr, err := a.service.MergePullRequest(ctx, req.PullRequestID)
+	pr, err := a.service.MergePullRequest(ctx, req.ToModel())
 	if err != nil {
 		return handlers.ConvertDomainError(c, err, "merge pull request")
 	}
@@ -50,6 +111,42 @@ func (a *API) mergePullRequest(c echo.Context) error {
 	})
 }
 
+func (a *API) markPullRequestReady(c echo.Context) error {
+	return a.changePullRequestStatus(c, a.service.MarkPullRequestReady, "mark pull request ready")
+}
+
+func (a *API) closePullRequest(c echo.Context) error {
+	return a.changePullRequestStatus(c, a.service.ClosePullRequest, "close pull request")
+}
+
+func (a *API) reopenPullRequest(c echo.Context) error {
+	return a.changePullRequestStatus(c, a.service.ReopenPullRequest, "reopen pull request")
+}
+
+func (a *API) changePullRequestStatus(
+	c echo.Context,
+	change func(ctx context.Context, prID string) (*models.PullRequest, error),
+	description string,
+) error {
+	var req dto.PullRequestStatusRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	pr, err := change(c.Request().Context(), req.PullRequestID)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, description)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"pr": dto.FromModelPullRequest(pr),
+	})
+}
+
 func (a *API) reassignPullRequest(c echo.Context) error {
 	var req dto.ReassignPullRequestRequest
 
@@ -61,7 +158,7 @@ func (a *API) reassignPullRequest(c echo.Context) error {
 	}
 
 	ctx := c.Request().Context()
-	pr, newReviewerID, err := a.service.ReassignReviewer(ctx, req.PullRequestID, req.OldUserID)
+	pr, newReviewerID, err := a.service.ReassignReviewer(ctx, req.ToModel())
 	if err != nil {
 		return handlers.ConvertDomainError(c, err, "reassign reviewer")
 	}
@@ -72,8 +169,125 @@ func (a *API) reassignPullRequest(c echo.Context) error {
 	})
 }
 
+func (a *API) claimReview(c echo.Context) error {
+	var req dto.ClaimReviewRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	pr, err := a.service.ClaimReview(ctx, req.ToModel())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "claim review")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"pr": dto.FromModelPullRequest(pr),
+	})
+}
+
+func (a *API) offerReviewSwap(c echo.Context) error {
+	var req dto.OfferReviewSwapRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	pr, offer, err := a.service.OfferReviewSwap(ctx, req.ToModel())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "offer review swap")
+	}
+
+	return c.JSON(http.StatusCreated, map[string]any{
+		"offer": dto.FromModelReviewSwapOffer(pr, offer),
+	})
+}
+
+func (a *API) addReviewer(c echo.Context) error {
+	var req dto.AddReviewerRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	pr, err := a.service.AddReviewer(ctx, req.ToModel())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "add reviewer")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"pr": dto.FromModelPullRequest(pr),
+	})
+}
+
+func (a *API) removeReviewer(c echo.Context) error {
+	var req dto.RemoveReviewerRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	pr, err := a.service.RemoveReviewer(ctx, req.ToModel())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "remove reviewer")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"pr": dto.FromModelPullRequest(pr),
+	})
+}
+
+func (a *API) submitReview(c echo.Context) error {
+	var req dto.SubmitReviewRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	pr, review, err := a.service.SubmitReview(ctx, req.ToModel())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "submit review")
+	}
+
+	return c.JSON(http.StatusCreated, map[string]any{
+		"pr":     dto.FromModelPullRequest(pr),
+		"review": dto.FromModelReview(review),
+	})
+}
+
 func (a *API) registerPullRequestHandlers(group *echo.Group) {
 	group.POST("/pullRequest/create", a.createPullRequest)
+	group.POST("/pullRequest/previewReviewers", a.previewReviewers)
+	group.GET("/pullRequest/history", a.getAssignmentHistory)
+	group.POST("/pullRequest/update", a.updatePullRequest)
 	group.POST("/pullRequest/merge", a.mergePullRequest)
+	group.POST("/pullRequest/markReady", a.markPullRequestReady)
+	group.POST("/pullRequest/close", a.closePullRequest)
+	group.POST("/pullRequest/reopen", a.reopenPullRequest)
 	group.POST("/pullRequest/reassign", a.reassignPullRequest)
+	group.POST("/pullRequest/claim", a.claimReview)
+	group.POST("/pullRequest/offerSwap", a.offerReviewSwap)
+	group.POST("/pullRequest/addReviewer", a.addReviewer)
+	group.POST("/pullRequest/removeReviewer", a.removeReviewer)
+	group.POST("/pullRequest/review", a.submitReview)
 }
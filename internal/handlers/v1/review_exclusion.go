@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"net/http"
+	"pr-review/internal/handlers"
+	"pr-review/internal/handlers/v1/dto"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (a *API) addReviewExclusion(c echo.Context) error {
+	var req dto.AddReviewExclusionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	exclusion, err := a.service.AddReviewExclusion(ctx, req.ToModel())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "add review exclusion")
+	}
+
+	return c.JSON(http.StatusCreated, map[string]any{
+		"exclusion": dto.FromModelReviewExclusion(exclusion),
+	})
+}
+
+func (a *API) listReviewExclusions(c echo.Context) error {
+	userID := c.QueryParam("user_id")
+	if userID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "user_id is required")
+	}
+
+	ctx := c.Request().Context()
+	exclusions, err := a.service.ListReviewExclusions(ctx, userID)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "list review exclusions")
+	}
+
+	return c.JSON(http.StatusOK, dto.ListReviewExclusionsResponse{
+		UserID:     userID,
+		Exclusions: dto.FromModelReviewExclusionList(exclusions),
+	})
+}
+
+func (a *API) deleteReviewExclusion(c echo.Context) error {
+	var req dto.DeleteReviewExclusionRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	exclusion, err := a.service.DeleteReviewExclusion(ctx, req.ID)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "delete review exclusion")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"exclusion": dto.FromModelReviewExclusion(exclusion),
+	})
+}
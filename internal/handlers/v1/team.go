@@ -12,6 +12,12 @@ func (a *API) registerTeamHandlers(group *echo.Group) {
 	group.POST("/team/add", a.createTeam)
 	group.GET("/team/get", a.getTeam)
 	group.POST("/team/deactivateMembers", a.deactivateTeamMembers)
+	group.GET("/team/settings/get", a.getTeamSettings)
+	group.POST("/team/settings/set", a.setTeamSettings)
+	group.POST("/team/settings/delete", a.deleteTeamSettings)
+	group.POST("/team/codeowners/upload", a.uploadCodeOwners)
+	group.GET("/team/codeowners/get", a.getCodeOwners)
+	group.GET("/team/pairings", a.getTeamPairings)
 }
 
 func (a *API) createTeam(c echo.Context) error {
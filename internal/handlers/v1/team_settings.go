@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"net/http"
+	"pr-review/internal/handlers"
+	"pr-review/internal/handlers/v1/dto"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (a *API) getTeamSettings(c echo.Context) error {
+	teamName := c.QueryParam("team_name")
+	if teamName == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "team_name is required")
+	}
+
+	ctx := c.Request().Context()
+	team, settings, err := a.service.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "get team settings")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"settings": dto.FromModelTeamSettings(team.Name, settings),
+	})
+}
+
+func (a *API) setTeamSettings(c echo.Context) error {
+	var req dto.SetTeamSettingsRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	team, settings, err := a.service.SetTeamSettings(ctx, req.TeamName, req.ToModelUpdate())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "set team settings")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"settings": dto.FromModelTeamSettings(team.Name, settings),
+	})
+}
+
+func (a *API) deleteTeamSettings(c echo.Context) error {
+	var req dto.DeleteTeamSettingsRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	team, settings, err := a.service.DeleteTeamSettings(ctx, req.TeamName)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "delete team settings")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"settings": dto.FromModelTeamSettings(team.Name, settings),
+	})
+}
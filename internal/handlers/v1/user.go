@@ -1,16 +1,29 @@
 package v1
 
 import (
+	"context"
 	"net/http"
 	"pr-review/internal/handlers"
 	"pr-review/internal/handlers/v1/dto"
+	"pr-review/internal/models"
 
 	"github.com/labstack/echo/v4"
 )
 
 func (a *API) registerUserHandlers(group *echo.Group) {
 	group.POST("/users/setIsActive", a.setIsActive)
+	group.POST("/users/setMaxOpenReviews", a.setMaxOpenReviews)
+	group.POST("/users/setSeniority", a.setSeniority)
+	group.POST("/users/setExpertise", a.setExpertise)
+	group.POST("/users/setWorkingHours", a.setWorkingHours)
+	group.POST("/users/setReviewWeight", a.setReviewWeight)
 	group.GET("/users/getReview", a.getUserReviews)
+	group.POST("/users/outOfOffice/add", a.addOutOfOffice)
+	group.GET("/users/outOfOffice/list", a.listOutOfOffice)
+	group.POST("/users/outOfOffice/delete", a.deleteOutOfOffice)
+	group.POST("/users/exclusions/add", a.addReviewExclusion)
+	group.GET("/users/exclusions/list", a.listReviewExclusions)
+	group.POST("/users/exclusions/delete", a.deleteReviewExclusion)
 }
 
 func (a *API) setIsActive(c echo.Context) error {
@@ -31,21 +44,131 @@ func (a *API) setIsActive(c echo.Context) error {
 		return handlers.ConvertDomainError(c, err, "set user active")
 	}
 
-	teamName := ""
-	if user.TeamID > 0 {
-		team, err := a.service.GetTeamByID(ctx, user.TeamID)
-		if err == nil && team != nil {
-			teamName = team.Name
-		}
+	resp := dto.FromModelUser(user, a.userTeamName(ctx, user))
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"user": resp,
+	})
+}
+
+func (a *API) setMaxOpenReviews(c echo.Context) error {
+	var req dto.SetMaxOpenReviewsRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	resp := dto.FromModelUser(user, teamName)
+	ctx := c.Request().Context()
+	user, err := a.service.SetUserMaxOpenReviews(ctx, req.UserID, req.MaxOpenReviews)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "set user max open reviews")
+	}
 
 	return c.JSON(http.StatusOK, map[string]any{
-		"user": resp,
+		"user": dto.FromModelUser(user, a.userTeamName(ctx, user)),
+	})
+}
+
+func (a *API) setSeniority(c echo.Context) error {
+	var req dto.SetSeniorityRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	user, err := a.service.SetUserSeniority(ctx, req.UserID, models.Seniority(req.Seniority))
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "set user seniority")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"user": dto.FromModelUser(user, a.userTeamName(ctx, user)),
+	})
+}
+
+func (a *API) setExpertise(c echo.Context) error {
+	var req dto.SetExpertiseRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	user, err := a.service.SetUserExpertise(ctx, req.UserID, req.Expertise)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "set user expertise")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"user": dto.FromModelUser(user, a.userTeamName(ctx, user)),
+	})
+}
+
+func (a *API) setWorkingHours(c echo.Context) error {
+	var req dto.SetWorkingHoursRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	user, err := a.service.SetUserWorkingHours(ctx, req.UserID, req.ToModel())
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "set user working hours")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"user": dto.FromModelUser(user, a.userTeamName(ctx, user)),
 	})
 }
 
+func (a *API) setReviewWeight(c echo.Context) error {
+	var req dto.SetReviewWeightRequest
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	user, err := a.service.SetUserReviewWeight(ctx, req.UserID, req.ReviewWeight)
+	if err != nil {
+		return handlers.ConvertDomainError(c, err, "set user review weight")
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"user": dto.FromModelUser(user, a.userTeamName(ctx, user)),
+	})
+}
+
+func (a *API) userTeamName(ctx context.Context, user *models.User) string {
+	if user.TeamID <= 0 {
+		return ""
+	}
+
+	team, err := a.service.GetTeamByID(ctx, user.TeamID)
+	if err != nil || team == nil {
+		return ""
+	}
+
+	return team.Name
+}
+
 func (a *API) getUserReviews(c echo.Context) error {
 	userIDStr := c.QueryParam("user_id")
 	if userIDStr == "" {
@@ -60,7 +183,7 @@ func (a *API) getUserReviews(c echo.Context) error {
 
 	resp := dto.GetReviewResponse{
 		UserID:       userIDStr,
-		PullRequests: dto.FromModelPullRequestShortList(prs),
+		PullRequests: dto.FromModelPullRequestShortList(userIDStr, prs),
 	}
 
 	return c.JSON(http.StatusOK, resp)
@@ -8,6 +8,11 @@ import (
 )
 
 func doRaw(t *testing.T, method, path, body string) (int, string) {
+	t.Helper()
+	return doRawWithHeaders(t, method, path, body, nil)
+}
+
+func doRawWithHeaders(t *testing.T, method, path, body string, headers map[string]string) (int, string) {
 	t.Helper()
 	req, err := http.NewRequest(method, baseURL+path, strings.NewReader(body))
 	if err != nil {
@@ -16,6 +21,9 @@ func doRaw(t *testing.T, method, path, body string) (int, string) {
 	if body != "" {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
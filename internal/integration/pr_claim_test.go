@@ -0,0 +1,160 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIntegration_ClaimReview_FillsSlotAndSwaps(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "claim-team",
+		"members": [
+			{"user_id": "cl-u1", "username": "ClUser1", "is_active": true},
+			{"user_id": "cl-u2", "username": "ClUser2", "is_active": true},
+			{"user_id": "cl-u3", "username": "ClUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "claim-other-team",
+		"members": [
+			{"user_id": "cl-o1", "username": "ClOther1", "is_active": true},
+			{"user_id": "cl-o2", "username": "ClOther2", "is_active": true},
+			{"user_id": "cl-o3", "username": "ClOther3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "claim-team",
+		"reviewers_count": 3,
+		"fallback": "UNDERSTAFFED"
+	}`, http.StatusOK)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "cl-pr-1",
+		"pull_request_name": "Claim me",
+		"author_id": "cl-u1"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"status":"UNDERSTAFFED"`)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o1"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"CROSS_TEAM"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-u1"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"REVIEWER_IS_AUTHOR"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o1",
+		"allow_cross_team": true
+	}`, http.StatusOK)
+	mustContain(t, body, `"status":"OPEN"`)
+	mustContain(t, body, `"cl-o1"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o2",
+		"allow_cross_team": true
+	}`, http.StatusConflict)
+	mustContain(t, body, `"NO_FREE_SLOT"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o2",
+		"replace_user_id": "cl-u2",
+		"allow_cross_team": true
+	}`, http.StatusConflict)
+	mustContain(t, body, `"SWAP_NOT_AGREED"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/offerSwap", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o2",
+		"claimer_id": "cl-o3"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"NOT_ASSIGNED"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/offerSwap", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-u2",
+		"claimer_id": "cl-o3"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"claimer_id":"cl-o3"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o2",
+		"replace_user_id": "cl-u2",
+		"allow_cross_team": true
+	}`, http.StatusConflict)
+	mustContain(t, body, `"SWAP_NOT_AGREED"`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/offerSwap", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-u2",
+		"claimer_id": "cl-o2"
+	}`, http.StatusCreated)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o2",
+		"replace_user_id": "cl-u2",
+		"allow_cross_team": true
+	}`, http.StatusOK)
+	mustContain(t, body, `"cl-o2"`)
+	if contains(body, `"cl-u2"`) {
+		t.Fatalf("cl-u2 should be replaced after agreeing to the swap, body=%s", body)
+	}
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o3",
+		"replace_user_id": "cl-u3",
+		"allow_cross_team": true
+	}`, http.StatusConflict)
+	mustContain(t, body, `"SWAP_NOT_AGREED"`)
+
+	doJSON(t, http.MethodPost, "/users/outOfOffice/add", `{
+		"user_id": "cl-u3",
+		"starts_at": "`+testNow.Add(-time.Hour).Format(time.RFC3339)+`",
+		"ends_at": "`+testNow.Add(24*time.Hour).Format(time.RFC3339)+`",
+		"reason": "sick leave"
+	}`, http.StatusCreated)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o3",
+		"replace_user_id": "cl-u3",
+		"allow_cross_team": true
+	}`, http.StatusOK)
+	mustContain(t, body, `"cl-o3"`)
+	if contains(body, `"cl-u3"`) {
+		t.Fatalf("cl-u3 should be replaced while out of office, body=%s", body)
+	}
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o3",
+		"replace_user_id": "cl-u2",
+		"allow_cross_team": true
+	}`, http.StatusConflict)
+	mustContain(t, body, `"NOT_ASSIGNED"`)
+
+	historyBody := doJSON(t, http.MethodGet, "/pullRequest/history?pull_request_id=cl-pr-1", "", http.StatusOK)
+	mustContain(t, historyBody, `"reviewer_id":"cl-o1","action":"ASSIGNED","reason":"CLAIMED"`)
+	mustContain(t, historyBody, `"reviewer_id":"cl-u2","action":"UNASSIGNED","reason":"REPLACED"`)
+	mustContain(t, historyBody, `"reviewer_id":"cl-u3","action":"UNASSIGNED","reason":"REPLACED"`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/merge", `{"pull_request_id": "cl-pr-1"}`, http.StatusOK)
+	body = doJSON(t, http.MethodPost, "/pullRequest/claim", `{
+		"pull_request_id": "cl-pr-1",
+		"user_id": "cl-o3",
+		"replace_user_id": "cl-o1",
+		"allow_cross_team": true
+	}`, http.StatusConflict)
+	mustContain(t, body, `"PR_MERGED"`)
+}
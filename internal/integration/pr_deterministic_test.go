@@ -0,0 +1,91 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestIntegration_DeterministicAssignment_SameInputSameReviewers(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "deterministic-team",
+		"members": [
+			{"user_id": "det-u1", "username": "DetUser1", "is_active": true},
+			{"user_id": "det-u2", "username": "DetUser2", "is_active": true},
+			{"user_id": "det-u3", "username": "DetUser3", "is_active": true},
+			{"user_id": "det-u4", "username": "DetUser4", "is_active": true},
+			{"user_id": "det-u5", "username": "DetUser5", "is_active": true},
+			{"user_id": "det-u6", "username": "DetUser6", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	req := `{
+		"pull_request_id": "det-pr-1",
+		"pull_request_name": "Deterministic",
+		"author_id": "det-u1"
+	}`
+
+	var first, second struct {
+		AssignedReviewers []string `json:"assigned_reviewers"`
+	}
+	if err := json.Unmarshal([]byte(doJSON(t, http.MethodPost, "/pullRequest/previewReviewers", req, http.StatusOK)), &first); err != nil {
+		t.Fatalf("decode preview: %v", err)
+	}
+	if err := json.Unmarshal([]byte(doJSON(t, http.MethodPost, "/pullRequest/previewReviewers", req, http.StatusOK)), &second); err != nil {
+		t.Fatalf("decode preview: %v", err)
+	}
+	if len(first.AssignedReviewers) != 2 || !reflect.DeepEqual(first.AssignedReviewers, second.AssignedReviewers) {
+		t.Fatalf("previews differ: %v vs %v", first.AssignedReviewers, second.AssignedReviewers)
+	}
+
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.Unmarshal([]byte(doJSON(t, http.MethodPost, "/pullRequest/create", req, http.StatusCreated)), &created); err != nil {
+		t.Fatalf("decode create: %v", err)
+	}
+	if !reflect.DeepEqual(created.PR.AssignedReviewers, first.AssignedReviewers) {
+		t.Fatalf("created reviewers %v differ from preview %v", created.PR.AssignedReviewers, first.AssignedReviewers)
+	}
+
+	doJSON(t, http.MethodPost, "/pullRequest/update", `{
+		"pull_request_id": "det-pr-1",
+		"new_pull_request_id": "det-pr-1-archived",
+		"version": 1
+	}`, http.StatusOK)
+
+	var recreated struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.Unmarshal([]byte(doJSON(t, http.MethodPost, "/pullRequest/create", req, http.StatusCreated)), &recreated); err != nil {
+		t.Fatalf("decode recreate: %v", err)
+	}
+	if !reflect.DeepEqual(recreated.PR.AssignedReviewers, created.PR.AssignedReviewers) {
+		t.Fatalf("recreated reviewers %v differ from original %v", recreated.PR.AssignedReviewers, created.PR.AssignedReviewers)
+	}
+
+	differs := false
+	for i := 2; i <= 10 && !differs; i++ {
+		var other struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		}
+		body := doJSON(t, http.MethodPost, "/pullRequest/previewReviewers", `{
+			"pull_request_id": "det-pr-`+strconv.Itoa(i)+`",
+			"pull_request_name": "Deterministic",
+			"author_id": "det-u1"
+		}`, http.StatusOK)
+		if err := json.Unmarshal([]byte(body), &other); err != nil {
+			t.Fatalf("decode preview: %v", err)
+		}
+		differs = !reflect.DeepEqual(other.AssignedReviewers, first.AssignedReviewers)
+	}
+	if !differs {
+		t.Fatalf("every pull_request_id produced reviewers %v", first.AssignedReviewers)
+	}
+}
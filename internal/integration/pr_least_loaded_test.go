@@ -0,0 +1,42 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_PRCreate_LeastLoaded_PrefersIdleReviewer(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "least-loaded-team",
+		"members": [
+			{"user_id": "ll-u1", "username": "LLUser1", "is_active": true},
+			{"user_id": "ll-u2", "username": "LLUser2", "is_active": true},
+			{"user_id": "ll-u3", "username": "LLUser3", "is_active": true},
+			{"user_id": "ll-u4", "username": "LLUser4", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	first := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "ll-pr-1",
+		"pull_request_name": "Least Loaded 1",
+		"author_id": "ll-u1"
+	}`, http.StatusCreated)
+
+	idle := ""
+	for _, id := range []string{"ll-u2", "ll-u3", "ll-u4"} {
+		if !contains(first, `"`+id+`"`) {
+			idle = id
+		}
+	}
+	if idle == "" {
+		t.Fatalf("expected one idle member after first PR, body=%s", first)
+	}
+
+	second := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "ll-pr-2",
+		"pull_request_name": "Least Loaded 2",
+		"author_id": "ll-u1"
+	}`, http.StatusCreated)
+
+	mustContain(t, second, `"`+idle+`"`)
+}
@@ -0,0 +1,115 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_PullRequestLifecycle_DraftCloseReopen(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "lifecycle-team",
+		"members": [
+			{"user_id": "lc-u1", "username": "LcUser1", "is_active": true},
+			{"user_id": "lc-u2", "username": "LcUser2", "is_active": true},
+			{"user_id": "lc-u3", "username": "LcUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "lc-pr-1",
+		"pull_request_name": "Work in progress",
+		"author_id": "lc-u1",
+		"draft": true
+	}`, http.StatusCreated)
+	mustContain(t, body, `"status":"DRAFT"`)
+	mustContain(t, body, `"assigned_reviewers":[]`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/merge", `{"pull_request_id": "lc-pr-1"}`, http.StatusConflict)
+	mustContain(t, body, `"INVALID_TRANSITION"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/reopen", `{"pull_request_id": "lc-pr-1"}`, http.StatusConflict)
+	mustContain(t, body, `"INVALID_TRANSITION"`)
+
+	statsBody := doJSON(t, http.MethodGet, "/stats", "", http.StatusOK)
+	if contains(statsBody, `"pull_request_id":"lc-pr-1"`) {
+		t.Fatalf("draft PR should not appear in stats, body=%s", statsBody)
+	}
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/markReady", `{"pull_request_id": "lc-pr-1"}`, http.StatusOK)
+	mustContain(t, body, `"status":"OPEN"`)
+	mustContain(t, body, `"lc-u2"`)
+	mustContain(t, body, `"lc-u3"`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "lc-pr-1",
+		"reviewer_id": "lc-u2",
+		"verdict": "APPROVED"
+	}`, http.StatusCreated)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/markReady", `{"pull_request_id": "lc-pr-1"}`, http.StatusConflict)
+	mustContain(t, body, `"INVALID_TRANSITION"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/close", `{"pull_request_id": "lc-pr-1"}`, http.StatusOK)
+	mustContain(t, body, `"status":"CLOSED"`)
+	mustContain(t, body, `"assigned_reviewers":[]`)
+
+	reviewBody := doJSON(t, http.MethodGet, "/users/getReview?user_id=lc-u2", "", http.StatusOK)
+	if contains(reviewBody, `"lc-pr-1"`) {
+		t.Fatalf("closed PR should not be listed for reviewer, body=%s", reviewBody)
+	}
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/reassign", `{
+		"pull_request_id": "lc-pr-1",
+		"old_user_id": "lc-u2"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"PR_NOT_OPEN"`)
+
+	historyBody := doJSON(t, http.MethodGet, "/pullRequest/history?pull_request_id=lc-pr-1", "", http.StatusOK)
+	mustContain(t, historyBody, `"reviewer_id":"lc-u2","action":"UNASSIGNED","reason":"CLOSED"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/reopen", `{"pull_request_id": "lc-pr-1"}`, http.StatusOK)
+	mustContain(t, body, `"status":"OPEN"`)
+	mustContain(t, body, `"lc-u2"`)
+	if contains(body, `"verdict":"APPROVED"`) {
+		t.Fatalf("approval from before close should not count after reopen, body=%s", body)
+	}
+
+	reviewBody = doJSON(t, http.MethodGet, "/users/getReview?user_id=lc-u2", "", http.StatusOK)
+	mustContain(t, reviewBody, `"lc-pr-1"`)
+}
+
+func TestIntegration_PullRequestLifecycle_MarkReadyKeepsCodeOwners(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "lifecycle-owners-team",
+		"members": [
+			{"user_id": "lco-u1", "username": "LcoUser1", "is_active": true},
+			{"user_id": "lco-u2", "username": "LcoUser2", "is_active": true},
+			{"user_id": "lco-u3", "username": "LcoUser3", "is_active": true},
+			{"user_id": "lco-u4", "username": "LcoUser4", "is_active": true},
+			{"user_id": "lco-u5", "username": "LcoUser5", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/codeowners/upload", `{
+		"team_name": "lifecycle-owners-team",
+		"content": "*.sql @lco-u4\n"
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "lco-pr-1",
+		"pull_request_name": "Draft migration",
+		"author_id": "lco-u1",
+		"changed_files": ["db/migration/000001_init.sql"],
+		"draft": true
+	}`, http.StatusCreated)
+	mustContain(t, body, `"status":"DRAFT"`)
+	mustContain(t, body, `"assigned_reviewers":[]`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/markReady", `{"pull_request_id": "lco-pr-1"}`, http.StatusOK)
+	mustContain(t, body, `"status":"OPEN"`)
+	mustContain(t, body, `"assigned_reviewers":["lco-u4",`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/close", `{"pull_request_id": "lco-pr-1"}`, http.StatusOK)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/reopen", `{"pull_request_id": "lco-pr-1"}`, http.StatusOK)
+	mustContain(t, body, `"assigned_reviewers":["lco-u4",`)
+}
@@ -0,0 +1,210 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIntegration_AddRemoveReviewer_RespectsTeamLimits(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "manual-team",
+		"members": [
+			{"user_id": "mr-u1", "username": "MrUser1", "is_active": true},
+			{"user_id": "mr-u2", "username": "MrUser2", "is_active": true},
+			{"user_id": "mr-u3", "username": "MrUser3", "is_active": true},
+			{"user_id": "mr-u4", "username": "MrUser4", "is_active": true},
+			{"user_id": "mr-u5", "username": "MrUser5", "is_active": false}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "manual-team",
+		"reviewers_count": 1,
+		"min_reviewers": 1,
+		"max_reviewers": 2
+	}`, http.StatusOK)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "mr-pr-1",
+		"pull_request_name": "Risky change",
+		"author_id": "mr-u1"
+	}`, http.StatusCreated)
+
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.Unmarshal([]byte(body), &created); err != nil {
+		t.Fatalf("decode create response: %v", err)
+	}
+	if len(created.PR.AssignedReviewers) != 1 {
+		t.Fatalf("expected one reviewer, body=%s", body)
+	}
+	assigned := created.PR.AssignedReviewers[0]
+
+	extra := "mr-u2"
+	if assigned == extra {
+		extra = "mr-u3"
+	}
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/removeReviewer", `{
+		"pull_request_id": "mr-pr-1",
+		"user_id": "`+assigned+`"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"MIN_REVIEWERS"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/addReviewer", `{
+		"pull_request_id": "mr-pr-1",
+		"user_id": "mr-u5"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"REVIEWER_INACTIVE"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/addReviewer", `{
+		"pull_request_id": "mr-pr-1",
+		"user_id": "mr-u1"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"REVIEWER_IS_AUTHOR"`)
+
+	doJSON(t, http.MethodPost, "/users/outOfOffice/add", `{
+		"user_id": "mr-u4",
+		"starts_at": "`+testNow.Add(-time.Hour).Format(time.RFC3339)+`",
+		"ends_at": "`+testNow.Add(24*time.Hour).Format(time.RFC3339)+`",
+		"reason": "vacation"
+	}`, http.StatusCreated)
+	body = doJSON(t, http.MethodPost, "/pullRequest/addReviewer", `{
+		"pull_request_id": "mr-pr-1",
+		"user_id": "mr-u4"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"REVIEWER_OUT_OF_OFFICE"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/addReviewer", `{
+		"pull_request_id": "mr-pr-1",
+		"user_id": "`+assigned+`"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"ALREADY_ASSIGNED"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/addReviewer", `{
+		"pull_request_id": "mr-pr-1",
+		"user_id": "`+extra+`"
+	}`, http.StatusOK)
+	mustContain(t, body, `"`+assigned+`"`)
+	mustContain(t, body, `"`+extra+`"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/addReviewer", `{
+		"pull_request_id": "mr-pr-1",
+		"user_id": "mr-u4"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"MAX_REVIEWERS"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/removeReviewer", `{
+		"pull_request_id": "mr-pr-1",
+		"user_id": "`+assigned+`"
+	}`, http.StatusOK)
+	if contains(body, `"`+assigned+`"`) {
+		t.Fatalf("%s should be removed, body=%s", assigned, body)
+	}
+
+	historyBody := doJSON(t, http.MethodGet, "/pullRequest/history?pull_request_id=mr-pr-1", "", http.StatusOK)
+	mustContain(t, historyBody, `"reviewer_id":"`+extra+`","action":"ASSIGNED","reason":"MANUAL"`)
+	mustContain(t, historyBody, `"reviewer_id":"`+assigned+`","action":"UNASSIGNED","reason":"MANUAL"`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/close", `{"pull_request_id": "mr-pr-1"}`, http.StatusOK)
+	body = doJSON(t, http.MethodPost, "/pullRequest/addReviewer", `{
+		"pull_request_id": "mr-pr-1",
+		"user_id": "mr-u4"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"PR_NOT_OPEN"`)
+}
+
+func TestIntegration_RemoveReviewer_MarksUnderstaffed(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "manual-understaffed-team",
+		"members": [
+			{"user_id": "mru-u1", "username": "MruUser1", "is_active": true},
+			{"user_id": "mru-u2", "username": "MruUser2", "is_active": true},
+			{"user_id": "mru-u3", "username": "MruUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "manual-understaffed-team",
+		"reviewers_count": 2,
+		"fallback": "UNDERSTAFFED"
+	}`, http.StatusOK)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "mru-pr-1",
+		"pull_request_name": "Understaffed",
+		"author_id": "mru-u1"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"status":"OPEN"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/removeReviewer", `{
+		"pull_request_id": "mru-pr-1",
+		"user_id": "mru-u2"
+	}`, http.StatusOK)
+	mustContain(t, body, `"status":"UNDERSTAFFED"`)
+	mustContain(t, body, `"assigned_reviewers":["mru-u3"]`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/addReviewer", `{
+		"pull_request_id": "mru-pr-1",
+		"user_id": "mru-u2"
+	}`, http.StatusOK)
+	mustContain(t, body, `"status":"OPEN"`)
+}
+
+func TestIntegration_RemoveReviewer_KeepsRequiredSenior(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "manual-senior-team",
+		"members": [
+			{"user_id": "mrs-u1", "username": "MrsUser1", "is_active": true, "seniority": "junior"},
+			{"user_id": "mrs-u2", "username": "MrsUser2", "is_active": true, "seniority": "senior"},
+			{"user_id": "mrs-u3", "username": "MrsUser3", "is_active": true, "seniority": "junior"},
+			{"user_id": "mrs-u4", "username": "MrsUser4", "is_active": true, "seniority": "junior"},
+			{"user_id": "mrs-u5", "username": "MrsUser5", "is_active": true, "seniority": "junior"}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "manual-senior-team",
+		"reviewers_count": 2,
+		"require_senior": true
+	}`, http.StatusOK)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "mrs-pr-1",
+		"pull_request_name": "Needs a senior",
+		"author_id": "mrs-u1"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"assigned_reviewers":["mrs-u2",`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/addReviewer", `{
+		"pull_request_id": "mrs-pr-1",
+		"user_id": "mrs-u5"
+	}`, http.StatusOK)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/removeReviewer", `{
+		"pull_request_id": "mrs-pr-1",
+		"user_id": "mrs-u2"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"SENIOR_REQUIRED"`)
+
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "manual-senior-team",
+		"reviewers_count": 2,
+		"require_senior": true,
+		"fallback": "UNDERSTAFFED"
+	}`, http.StatusOK)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/removeReviewer", `{
+		"pull_request_id": "mrs-pr-1",
+		"user_id": "mrs-u2"
+	}`, http.StatusOK)
+	mustContain(t, body, `"status":"UNDERSTAFFED"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/addReviewer", `{
+		"pull_request_id": "mrs-pr-1",
+		"user_id": "mrs-u2"
+	}`, http.StatusOK)
+	mustContain(t, body, `"status":"OPEN"`)
+}
@@ -0,0 +1,102 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_MergeGating_RequiresApprovals(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "gating-team",
+		"members": [
+			{"user_id": "gt-u1", "username": "GtUser1", "is_active": true},
+			{"user_id": "gt-u2", "username": "GtUser2", "is_active": true},
+			{"user_id": "gt-u3", "username": "GtUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "gating-team",
+		"reviewers_count": 2,
+		"min_approvals": 1,
+		"mandatory_reviewers": ["gt-u2"]
+	}`, http.StatusOK)
+
+	doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "gt-pr-1",
+		"pull_request_name": "Gated",
+		"author_id": "gt-u1"
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/merge", `{"pull_request_id": "gt-pr-1"}`, http.StatusConflict)
+	mustContain(t, body, `"MERGE_BLOCKED"`)
+	mustContain(t, body, `0 of 1 required approvals`)
+	mustContain(t, body, `mandatory reviewers not approved: gt-u2`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "gt-pr-1",
+		"reviewer_id": "gt-u3",
+		"verdict": "CHANGES_REQUESTED"
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "gt-pr-1",
+		"reviewer_id": "gt-u2",
+		"verdict": "APPROVED"
+	}`, http.StatusCreated)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/merge", `{"pull_request_id": "gt-pr-1"}`, http.StatusConflict)
+	mustContain(t, body, `changes requested by gt-u3`)
+	if contains(body, `required approvals`) || contains(body, `mandatory reviewers`) {
+		t.Fatalf("only changes requested should block, body=%s", body)
+	}
+
+	doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "gt-pr-1",
+		"reviewer_id": "gt-u3",
+		"verdict": "APPROVED"
+	}`, http.StatusCreated)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/merge", `{"pull_request_id": "gt-pr-1"}`, http.StatusOK)
+	mustContain(t, body, `"status":"MERGED"`)
+	if contains(body, `"forced_merge"`) {
+		t.Fatalf("merge should not be forced, body=%s", body)
+	}
+}
+
+func TestIntegration_MergeGating_AdminForce(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "gating-force-team",
+		"members": [
+			{"user_id": "gf-u1", "username": "GfUser1", "is_active": true},
+			{"user_id": "gf-u2", "username": "GfUser2", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "gating-force-team",
+		"reviewers_count": 1,
+		"min_approvals": 1
+	}`, http.StatusOK)
+	doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "gf-pr-1",
+		"pull_request_name": "Hotfix",
+		"author_id": "gf-u1"
+	}`, http.StatusCreated)
+
+	code, body := doRaw(t, http.MethodPost, "/pullRequest/merge", `{"pull_request_id": "gf-pr-1", "force": true}`)
+	if code != http.StatusForbidden {
+		t.Fatalf("want 403 without admin token, got %d body=%s", code, body)
+	}
+
+	code, body = doRawWithHeaders(t, http.MethodPost, "/pullRequest/merge", `{"pull_request_id": "gf-pr-1", "force": true}`,
+		map[string]string{"X-Admin-Token": "wrong"})
+	if code != http.StatusForbidden {
+		t.Fatalf("want 403 with wrong admin token, got %d body=%s", code, body)
+	}
+
+	code, body = doRawWithHeaders(t, http.MethodPost, "/pullRequest/merge", `{"pull_request_id": "gf-pr-1", "force": true}`,
+		map[string]string{"X-Admin-Token": testAdminToken})
+	if code != http.StatusOK {
+		t.Fatalf("want 200 on forced merge, got %d body=%s", code, body)
+	}
+	mustContain(t, body, `"status":"MERGED"`)
+	mustContain(t, body, `"forced_merge":true,"merge_blockers":["0 of 1 required approvals"]`)
+}
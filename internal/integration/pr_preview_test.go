@@ -0,0 +1,54 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIntegration_PreviewReviewers_ExplainsWithoutWriting(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "preview-team",
+		"members": [
+			{"user_id": "prev-u1", "username": "PrevUser1", "is_active": true},
+			{"user_id": "prev-u2", "username": "PrevUser2", "is_active": true},
+			{"user_id": "prev-u3", "username": "PrevUser3", "is_active": false},
+			{"user_id": "prev-u4", "username": "PrevUser4", "is_active": true},
+			{"user_id": "prev-u5", "username": "PrevUser5", "is_active": true},
+			{"user_id": "prev-u6", "username": "PrevUser6", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	startsAt := testNow.Add(-time.Hour).Format(time.RFC3339)
+	endsAt := testNow.Add(24 * time.Hour).Format(time.RFC3339)
+	doJSON(t, http.MethodPost, "/users/outOfOffice/add", `{
+		"user_id": "prev-u4",
+		"starts_at": "`+startsAt+`",
+		"ends_at": "`+endsAt+`"
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/users/setMaxOpenReviews", `{"user_id":"prev-u5","max_open_reviews":0}`, http.StatusOK)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/previewReviewers", `{
+		"pull_request_id": "prev-pr-1",
+		"pull_request_name": "Preview",
+		"author_id": "prev-u1"
+	}`, http.StatusOK)
+	mustContain(t, body, `{"user_id":"prev-u1","team_name":"preview-team","selected":false,"reason":"AUTHOR"}`)
+	mustContain(t, body, `{"user_id":"prev-u2","team_name":"preview-team","selected":true,"reason":"STRATEGY"}`)
+	mustContain(t, body, `{"user_id":"prev-u3","team_name":"preview-team","selected":false,"reason":"INACTIVE"}`)
+	mustContain(t, body, `{"user_id":"prev-u4","team_name":"preview-team","selected":false,"reason":"OUT_OF_OFFICE"}`)
+	mustContain(t, body, `{"user_id":"prev-u5","team_name":"preview-team","selected":false,"reason":"OVER_CAPACITY"}`)
+	mustContain(t, body, `{"user_id":"prev-u6","team_name":"preview-team","selected":true,"reason":"STRATEGY"}`)
+
+	doJSON(t, http.MethodGet, "/pullRequest/history?pull_request_id=prev-pr-1", "", http.StatusNotFound)
+
+	doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "prev-pr-1",
+		"pull_request_name": "Preview",
+		"author_id": "prev-u1"
+	}`, http.StatusCreated)
+
+	body = doJSON(t, http.MethodGet, "/pullRequest/history?pull_request_id=prev-pr-1", "", http.StatusOK)
+	mustContain(t, body, `"reviewer_id":"prev-u2","action":"ASSIGNED","reason":"STRATEGY"`)
+	mustContain(t, body, `"reviewer_id":"prev-u6","action":"ASSIGNED","reason":"STRATEGY"`)
+}
@@ -0,0 +1,95 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_ReassignExplicit_ChecksChosenReviewer(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "explicit-team",
+		"members": [
+			{"user_id": "rx-u1", "username": "RxUser1", "is_active": true},
+			{"user_id": "rx-u2", "username": "RxUser2", "is_active": true},
+			{"user_id": "rx-u3", "username": "RxUser3", "is_active": true},
+			{"user_id": "rx-u4", "username": "RxUser4", "is_active": false}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "explicit-other-team",
+		"members": [
+			{"user_id": "rx-o1", "username": "RxOther1", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "explicit-team",
+		"reviewers_count": 1
+	}`, http.StatusOK)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "rx-pr-1",
+		"pull_request_name": "Explicit",
+		"author_id": "rx-u1"
+	}`, http.StatusCreated)
+
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.Unmarshal([]byte(prBody), &created); err != nil {
+		t.Fatalf("decode pr: %v", err)
+	}
+	if len(created.PR.AssignedReviewers) != 1 {
+		t.Fatalf("want 1 reviewer, got %v", created.PR.AssignedReviewers)
+	}
+	current := created.PR.AssignedReviewers[0]
+	target := "rx-u2"
+	if current == target {
+		target = "rx-u3"
+	}
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/reassign", `{
+		"pull_request_id": "rx-pr-1",
+		"old_user_id": "`+current+`",
+		"new_user_id": "`+target+`"
+	}`, http.StatusOK)
+	mustContain(t, body, `"replaced_by":"`+target+`"`)
+	mustContain(t, body, `"assigned_reviewers":["`+target+`"]`)
+
+	cases := []struct {
+		newUserID string
+		code      string
+	}{
+		{"rx-u1", "REVIEWER_IS_AUTHOR"},
+		{"rx-u4", "REVIEWER_INACTIVE"},
+		{target, "ALREADY_ASSIGNED"},
+		{"rx-o1", "CROSS_TEAM"},
+	}
+	for _, tc := range cases {
+		body := doJSON(t, http.MethodPost, "/pullRequest/reassign", `{
+			"pull_request_id": "rx-pr-1",
+			"old_user_id": "`+target+`",
+			"new_user_id": "`+tc.newUserID+`"
+		}`, http.StatusConflict)
+		mustContain(t, body, `"`+tc.code+`"`)
+	}
+
+	doJSON(t, http.MethodPost, "/pullRequest/reassign", `{
+		"pull_request_id": "rx-pr-1",
+		"old_user_id": "`+target+`",
+		"new_user_id": "rx-missing"
+	}`, http.StatusNotFound)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/reassign", `{
+		"pull_request_id": "rx-pr-1",
+		"old_user_id": "`+target+`",
+		"new_user_id": "rx-o1",
+		"allow_cross_team": true
+	}`, http.StatusOK)
+	mustContain(t, body, `"replaced_by":"rx-o1"`)
+
+	historyBody := doJSON(t, http.MethodGet, "/pullRequest/history?pull_request_id=rx-pr-1", "", http.StatusOK)
+	mustContain(t, historyBody, `"reviewer_id":"rx-o1","action":"ASSIGNED","reason":"MANUAL"`)
+}
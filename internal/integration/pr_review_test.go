@@ -0,0 +1,79 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_SubmitReview_TracksLatestVerdict(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "verdict-team",
+		"members": [
+			{"user_id": "vd-u1", "username": "VdUser1", "is_active": true},
+			{"user_id": "vd-u2", "username": "VdUser2", "is_active": true},
+			{"user_id": "vd-u3", "username": "VdUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "vd-pr-1",
+		"pull_request_name": "Verdicts",
+		"author_id": "vd-u1"
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "vd-pr-1",
+		"reviewer_id": "vd-u2",
+		"verdict": "COMMENTED",
+		"body": "looks fine, one question"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"review":{"reviewer_id":"vd-u2","verdict":"COMMENTED","body":"looks fine, one question"`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "vd-pr-1",
+		"reviewer_id": "vd-u3",
+		"verdict": "CHANGES_REQUESTED",
+		"body": "missing tests"
+	}`, http.StatusCreated)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "vd-pr-1",
+		"reviewer_id": "vd-u2",
+		"verdict": "APPROVED"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"reviewer_id":"vd-u2","verdict":"APPROVED"`)
+	mustContain(t, body, `"reviewer_id":"vd-u3","verdict":"CHANGES_REQUESTED","body":"missing tests"`)
+	if contains(body, `"verdict":"COMMENTED"`) {
+		t.Fatalf("only the latest verdict per reviewer expected, body=%s", body)
+	}
+
+	reviewsBody := doJSON(t, http.MethodGet, "/users/getReview?user_id=vd-u2", "", http.StatusOK)
+	mustContain(t, reviewsBody, `"pull_request_id":"vd-pr-1","pull_request_name":"Verdicts","author_id":"vd-u1","status":"OPEN","verdict":"APPROVED"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "vd-pr-1",
+		"reviewer_id": "vd-u1",
+		"verdict": "APPROVED"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"NOT_ASSIGNED"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "vd-pr-1",
+		"reviewer_id": "vd-u2",
+		"verdict": "LGTM"
+	}`, http.StatusBadRequest)
+	mustContain(t, body, `"INVALID_ARGUMENT"`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "vd-pr-1",
+		"reviewer_id": "vd-u3",
+		"verdict": "APPROVED"
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/pullRequest/merge", `{"pull_request_id": "vd-pr-1"}`, http.StatusOK)
+	body = doJSON(t, http.MethodPost, "/pullRequest/review", `{
+		"pull_request_id": "vd-pr-1",
+		"reviewer_id": "vd-u2",
+		"verdict": "COMMENTED"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"PR_MERGED"`)
+}
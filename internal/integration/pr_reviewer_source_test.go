@@ -0,0 +1,33 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_ReviewerSources_KeepAssignmentTeam(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "source-team",
+		"members": [
+			{"user_id": "src-u1", "username": "SrcUser1", "is_active": true},
+			{"user_id": "src-u2", "username": "SrcUser2", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "src-pr-1",
+		"pull_request_name": "Source team",
+		"author_id": "src-u1"
+	}`, http.StatusCreated)
+	mustContain(t, body, `{"user_id":"src-u2","team_name":"source-team"}`)
+
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "source-moved-team",
+		"members": [
+			{"user_id": "src-u2", "username": "SrcUser2", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/merge", `{"pull_request_id": "src-pr-1"}`, http.StatusOK)
+	mustContain(t, body, `{"user_id":"src-u2","team_name":"source-team"}`)
+}
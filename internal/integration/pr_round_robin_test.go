@@ -0,0 +1,132 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestIntegration_PRCreate_RoundRobin_RotatesReviewers(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "round-robin-team",
+		"members": [
+			{"user_id": "rr-u1", "username": "RRUser1", "is_active": true},
+			{"user_id": "rr-u2", "username": "RRUser2", "is_active": true},
+			{"user_id": "rr-u3", "username": "RRUser3", "is_active": true},
+			{"user_id": "rr-u4", "username": "RRUser4", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	first := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "rr-pr-1",
+		"pull_request_name": "Round Robin 1",
+		"author_id": "rr-u1"
+	}`, http.StatusCreated)
+	mustContain(t, first, `"assigned_reviewers":["rr-u2","rr-u3"]`)
+
+	second := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "rr-pr-2",
+		"pull_request_name": "Round Robin 2",
+		"author_id": "rr-u1"
+	}`, http.StatusCreated)
+	mustContain(t, second, `"assigned_reviewers":["rr-u4","rr-u2"]`)
+}
+
+func TestIntegration_PRCreate_RoundRobin_FailedCreateKeepsRotation(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "round-robin-reject-team",
+		"members": [
+			{"user_id": "rrr-u1", "username": "RRRUser1", "is_active": true},
+			{"user_id": "rrr-u2", "username": "RRRUser2", "is_active": true},
+			{"user_id": "rrr-u3", "username": "RRRUser3", "is_active": true, "seniority": "senior"},
+			{"user_id": "rrr-u4", "username": "RRRUser4", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "round-robin-reject-team",
+		"strategy": "round_robin",
+		"reviewers_count": 4,
+		"fallback": "REJECT",
+		"require_senior": true
+	}`, http.StatusOK)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "rrr-pr-1",
+		"pull_request_name": "Rejected",
+		"author_id": "rrr-u1"
+	}`, http.StatusConflict)
+	mustContain(t, body, `"NOT_ENOUGH_CANDIDATES"`)
+
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "round-robin-reject-team",
+		"reviewers_count": 1,
+		"require_senior": false
+	}`, http.StatusOK)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "rrr-pr-2",
+		"pull_request_name": "Accepted",
+		"author_id": "rrr-u1"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"assigned_reviewers":["rrr-u2"]`)
+}
+
+func TestIntegration_PRCreate_RoundRobin_ConcurrentCreatesGetDifferentReviewers(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "round-robin-concurrent-team",
+		"members": [
+			{"user_id": "rrc-u1", "username": "RRCUser1", "is_active": true},
+			{"user_id": "rrc-u2", "username": "RRCUser2", "is_active": true},
+			{"user_id": "rrc-u3", "username": "RRCUser3", "is_active": true},
+			{"user_id": "rrc-u4", "username": "RRCUser4", "is_active": true},
+			{"user_id": "rrc-u5", "username": "RRCUser5", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "round-robin-concurrent-team",
+		"strategy": "round_robin",
+		"reviewers_count": 1
+	}`, http.StatusOK)
+
+	const prs = 4
+	codes := make([]int, prs)
+	bodies := make([]string, prs)
+	var wg sync.WaitGroup
+	for i := 0; i < prs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i], bodies[i] = doRaw(t, http.MethodPost, "/pullRequest/create", fmt.Sprintf(`{
+				"pull_request_id": "rrc-pr-%d",
+				"pull_request_name": "Concurrent",
+				"author_id": "rrc-u1"
+			}`, i))
+		}(i)
+	}
+	wg.Wait()
+
+	seen := make(map[string]bool, prs)
+	for i := 0; i < prs; i++ {
+		if codes[i] != http.StatusCreated {
+			t.Fatalf("create rrc-pr-%d: got %d, body=%s", i, codes[i], bodies[i])
+		}
+		var created struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		if err := json.Unmarshal([]byte(bodies[i]), &created); err != nil {
+			t.Fatalf("decode create response: %v", err)
+		}
+		if len(created.PR.AssignedReviewers) != 1 {
+			t.Fatalf("expected one reviewer, body=%s", bodies[i])
+		}
+		reviewer := created.PR.AssignedReviewers[0]
+		if seen[reviewer] {
+			t.Fatalf("reviewer %s assigned to more than one concurrent PR", reviewer)
+		}
+		seen[reviewer] = true
+	}
+}
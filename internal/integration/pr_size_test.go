@@ -0,0 +1,114 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_PullRequestSize_DrivesReviewersCount(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "size-team",
+		"members": [
+			{"user_id": "sz-u1", "username": "SzUser1", "is_active": true},
+			{"user_id": "sz-u2", "username": "SzUser2", "is_active": true},
+			{"user_id": "sz-u3", "username": "SzUser3", "is_active": true},
+			{"user_id": "sz-u4", "username": "SzUser4", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "size-team",
+		"reviewers_count": 2,
+		"size_rules": [
+			{"max_lines": 50, "max_files": 3, "reviewers_count": 1},
+			{"max_lines": 500, "reviewers_count": 2},
+			{"reviewers_count": 3}
+		]
+	}`, http.StatusOK)
+	mustContain(t, body, `"size_rules":[{"max_lines":50,"max_files":3,"reviewers_count":1}`)
+
+	cases := []struct {
+		id        string
+		size      string
+		reviewers int
+	}{
+		{"sz-pr-small", `"lines_added": 20, "lines_removed": 10, "files_changed": 2`, 1},
+		{"sz-pr-many-files", `"lines_added": 20, "files_changed": 10`, 2},
+		{"sz-pr-large", `"lines_added": 900, "lines_removed": 300`, 3},
+		{"sz-pr-unknown", ``, 2},
+	}
+	for _, tc := range cases {
+		payload := `{"pull_request_id": "` + tc.id + `", "pull_request_name": "Size", "author_id": "sz-u1"`
+		if tc.size != "" {
+			payload += `, ` + tc.size
+		}
+		payload += `}`
+
+		prBody := doJSON(t, http.MethodPost, "/pullRequest/create", payload, http.StatusCreated)
+
+		var resp struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		if err := json.Unmarshal([]byte(prBody), &resp); err != nil {
+			t.Fatalf("decode pr: %v", err)
+		}
+		if len(resp.PR.AssignedReviewers) != tc.reviewers {
+			t.Fatalf("%s: want %d reviewers, got %v", tc.id, tc.reviewers, resp.PR.AssignedReviewers)
+		}
+	}
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "sz-pr-stored",
+		"pull_request_name": "Size",
+		"author_id": "sz-u1",
+		"lines_added": 7,
+		"files_changed": 1
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"size":{"lines_added":7,"files_changed":1}`)
+
+	code, body := doRaw(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "sz-pr-negative",
+		"pull_request_name": "Size",
+		"author_id": "sz-u1",
+		"lines_added": -1
+	}`)
+	if code != http.StatusBadRequest {
+		t.Fatalf("want 400 for negative size, got %d body=%s", code, body)
+	}
+}
+
+func TestIntegration_PullRequestSize_RulesRespectMaxReviewers(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "size-limit-team",
+		"members": [
+			{"user_id": "szl-u1", "username": "SzlUser1", "is_active": true},
+			{"user_id": "szl-u2", "username": "SzlUser2", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	code, body := doRaw(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "size-limit-team",
+		"reviewers_count": 1,
+		"max_reviewers": 2,
+		"size_rules": [
+			{"max_lines": 50, "reviewers_count": 1},
+			{"reviewers_count": 3}
+		]
+	}`)
+	if code != http.StatusBadRequest || !contains(body, "must not exceed max_reviewers") {
+		t.Fatalf("want 400 for size rule above max_reviewers, got %d body=%s", code, body)
+	}
+
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "size-limit-team",
+		"reviewers_count": 1,
+		"max_reviewers": 2,
+		"size_rules": [
+			{"max_lines": 50, "reviewers_count": 1},
+			{"reviewers_count": 2}
+		]
+	}`, http.StatusOK)
+}
@@ -0,0 +1,160 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_UpdatePullRequest_MetadataAndVersion(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "update-team",
+		"members": [
+			{"user_id": "up-u1", "username": "UpUser1", "is_active": true},
+			{"user_id": "up-u2", "username": "UpUser2", "is_active": true},
+			{"user_id": "up-u3", "username": "UpUser3", "is_active": true},
+			{"user_id": "up-u4", "username": "UpUser4", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "update-team",
+		"reviewers_count": 1
+	}`, http.StatusOK)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "up-pr-1",
+		"pull_request_name": "Initial title",
+		"author_id": "up-u1"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"version":1`)
+
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.Unmarshal([]byte(body), &created); err != nil {
+		t.Fatalf("decode create response: %v", err)
+	}
+	if len(created.PR.AssignedReviewers) != 1 {
+		t.Fatalf("expected one reviewer, body=%s", body)
+	}
+	reviewer := created.PR.AssignedReviewers[0]
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/update", `{
+		"pull_request_id": "up-pr-1",
+		"new_pull_request_id": "up-pr-1-renamed",
+		"pull_request_name": "Renamed title",
+		"version": 1
+	}`, http.StatusOK)
+	mustContain(t, body, `"pull_request_id":"up-pr-1-renamed"`)
+	mustContain(t, body, `"pull_request_name":"Renamed title"`)
+	mustContain(t, body, `"version":2`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/update", `{
+		"pull_request_id": "up-pr-1-renamed",
+		"pull_request_name": "Stale edit",
+		"version": 1
+	}`, http.StatusConflict)
+	mustContain(t, body, `"VERSION_CONFLICT"`)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/update", `{
+		"pull_request_id": "up-pr-1-renamed",
+		"author_id": "`+reviewer+`",
+		"version": 2
+	}`, http.StatusOK)
+	mustContain(t, body, `"author_id":"`+reviewer+`"`)
+	mustContain(t, body, `"version":3`)
+
+	var updated struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.Unmarshal([]byte(body), &updated); err != nil {
+		t.Fatalf("decode update response: %v", err)
+	}
+	if len(updated.PR.AssignedReviewers) != 1 || updated.PR.AssignedReviewers[0] == reviewer {
+		t.Fatalf("new author should be replaced as reviewer, body=%s", body)
+	}
+
+	historyBody := doJSON(t, http.MethodGet, "/pullRequest/history?pull_request_id=up-pr-1-renamed", "", http.StatusOK)
+	mustContain(t, historyBody, `"reviewer_id":"`+reviewer+`","action":"UNASSIGNED","reason":"AUTHOR"`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "up-pr-2",
+		"pull_request_name": "Other",
+		"author_id": "up-u1"
+	}`, http.StatusCreated)
+	body = doJSON(t, http.MethodPost, "/pullRequest/update", `{
+		"pull_request_id": "up-pr-2",
+		"new_pull_request_id": "up-pr-1-renamed",
+		"version": 1
+	}`, http.StatusConflict)
+	mustContain(t, body, `"PR_EXISTS"`)
+}
+
+func TestIntegration_UpdatePullRequest_NewAuthorExclusions(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "update-exclusion-team",
+		"members": [
+			{"user_id": "upx-u1", "username": "UpxUser1", "is_active": true},
+			{"user_id": "upx-u2", "username": "UpxUser2", "is_active": true},
+			{"user_id": "upx-u3", "username": "UpxUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "update-exclusion-team",
+		"reviewers_count": 1
+	}`, http.StatusOK)
+
+	body := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "upx-pr-1",
+		"pull_request_name": "Exclusions",
+		"author_id": "upx-u1"
+	}`, http.StatusCreated)
+
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.Unmarshal([]byte(body), &created); err != nil {
+		t.Fatalf("decode create response: %v", err)
+	}
+	if len(created.PR.AssignedReviewers) != 1 {
+		t.Fatalf("expected one reviewer, body=%s", body)
+	}
+	reviewer := created.PR.AssignedReviewers[0]
+	newAuthor := "upx-u2"
+	if reviewer == newAuthor {
+		newAuthor = "upx-u3"
+	}
+
+	doJSON(t, http.MethodPost, "/users/exclusions/add", `{
+		"reviewer_id": "`+reviewer+`",
+		"author_id": "`+newAuthor+`"
+	}`, http.StatusCreated)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/update", `{
+		"pull_request_id": "upx-pr-1",
+		"author_id": "`+newAuthor+`",
+		"version": 1
+	}`, http.StatusOK)
+	mustContain(t, body, `"assigned_reviewers":["upx-u1"]`)
+
+	historyBody := doJSON(t, http.MethodGet, "/pullRequest/history?pull_request_id=upx-pr-1", "", http.StatusOK)
+	mustContain(t, historyBody, `"reviewer_id":"`+reviewer+`","action":"UNASSIGNED","reason":"EXCLUDED"`)
+
+	doJSON(t, http.MethodPost, "/users/exclusions/add", `{
+		"reviewer_id": "upx-u1",
+		"author_id": "`+reviewer+`"
+	}`, http.StatusCreated)
+
+	body = doJSON(t, http.MethodPost, "/pullRequest/update", `{
+		"pull_request_id": "upx-pr-1",
+		"author_id": "`+reviewer+`",
+		"version": 2
+	}`, http.StatusConflict)
+	mustContain(t, body, `"NO_CANDIDATE"`)
+}
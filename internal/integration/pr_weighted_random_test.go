@@ -0,0 +1,41 @@
+package integration
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIntegration_WeightedRandom_FollowsUserWeights(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "weighted-team",
+		"members": [
+			{"user_id": "wr-u1", "username": "WrUser1", "is_active": true},
+			{"user_id": "wr-u2", "username": "WrUser2", "is_active": true},
+			{"user_id": "wr-u3", "username": "WrUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/users/setReviewWeight", `{"user_id":"wr-u3","review_weight":0.000000001}`, http.StatusOK)
+	mustContain(t, body, `"review_weight":1e-9`)
+
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "weighted-team",
+		"reviewers_count": 1,
+		"strategy": "weighted_random"
+	}`, http.StatusOK)
+
+	for _, id := range []string{"wr-pr-1", "wr-pr-2", "wr-pr-3", "wr-pr-4"} {
+		prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+			"pull_request_id": "`+id+`",
+			"pull_request_name": "Weighted",
+			"author_id": "wr-u1"
+		}`, http.StatusCreated)
+		mustContain(t, prBody, `"assigned_reviewers":["wr-u2"]`)
+	}
+
+	code, body := doRaw(t, http.MethodPost, "/users/setReviewWeight", `{"user_id":"wr-u2","review_weight":0}`)
+	if code != http.StatusBadRequest || !strings.Contains(body, `"INVALID_ARGUMENT"`) {
+		t.Fatalf("want 400 INVALID_ARGUMENT, got %d body=%s", code, body)
+	}
+}
@@ -0,0 +1,93 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_CodeOwners_PicksPathOwnersFirst(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "codeowners-team",
+		"members": [
+			{"user_id": "co-u1", "username": "CoUser1", "is_active": true},
+			{"user_id": "co-u2", "username": "CoUser2", "is_active": true},
+			{"user_id": "co-u3", "username": "CoUser3", "is_active": true},
+			{"user_id": "co-u4", "username": "CoUser4", "is_active": true},
+			{"user_id": "co-u5", "username": "CoUser5", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/team/codeowners/upload", `{
+		"team_name": "codeowners-team",
+		"content": "* @co-u2\n"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"version":1`)
+
+	body = doJSON(t, http.MethodPost, "/team/codeowners/upload", `{
+		"team_name": "codeowners-team",
+		"content": "# owners\n* @co-u2\n/db/ @co-u3\n*.sql @co-u4\n"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"version":2`)
+
+	body = doJSON(t, http.MethodGet, "/team/codeowners/get?team_name=codeowners-team&version=1", "", http.StatusOK)
+	mustContain(t, body, `"content":"* @co-u2\n"`)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "co-pr-1",
+		"pull_request_name": "Migrations",
+		"author_id": "co-u1",
+		"changed_files": ["db/migration/000001_init.sql"]
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["co-u4",`)
+
+	prBody = doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "co-pr-2",
+		"pull_request_name": "Schema docs",
+		"author_id": "co-u1",
+		"changed_files": ["db/README.md"]
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["co-u3",`)
+}
+
+func TestIntegration_CodeOwners_SingleStarStaysInDirectory(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "codeowners-star-team",
+		"members": [
+			{"user_id": "cos-u1", "username": "CosUser1", "is_active": true},
+			{"user_id": "cos-u2", "username": "CosUser2", "is_active": true},
+			{"user_id": "cos-u3", "username": "CosUser3", "is_active": true},
+			{"user_id": "cos-u4", "username": "CosUser4", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/codeowners/upload", `{
+		"team_name": "codeowners-star-team",
+		"content": "docs/* @cos-u2\nassets/** @cos-u3\n"
+	}`, http.StatusCreated)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "cos-pr-1",
+		"pull_request_name": "Top-level docs",
+		"author_id": "cos-u1",
+		"changed_files": ["docs/index.md"]
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["cos-u2",`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "cos-pr-2",
+		"pull_request_name": "Nested docs",
+		"author_id": "cos-u1",
+		"changed_files": ["docs/guide/intro.md"]
+	}`, http.StatusCreated)
+	historyBody := doJSON(t, http.MethodGet, "/pullRequest/history?pull_request_id=cos-pr-2", "", http.StatusOK)
+	if contains(historyBody, `"reason":"CODE_OWNER"`) {
+		t.Fatalf("docs/* should not match nested paths, body=%s", historyBody)
+	}
+
+	prBody = doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "cos-pr-3",
+		"pull_request_name": "Nested assets",
+		"author_id": "cos-u1",
+		"changed_files": ["assets/img/logo.svg"]
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["cos-u3",`)
+}
@@ -0,0 +1,43 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_TeamFallback_FillsMissingReviewers(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "fallback-small-team",
+		"members": [
+			{"user_id": "fb-u1", "username": "FbUser1", "is_active": true},
+			{"user_id": "fb-u2", "username": "FbUser2", "is_active": true}
+		]
+	}`, http.StatusCreated)
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "fallback-pool-team",
+		"members": [
+			{"user_id": "fb-p1", "username": "FbPool1", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "fallback-small-team",
+		"fallback_teams": ["fallback-pool-team"]
+	}`, http.StatusOK)
+	mustContain(t, body, `"fallback_teams":["fallback-pool-team"]`)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "fb-pr-1",
+		"pull_request_name": "Fallback",
+		"author_id": "fb-u1"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["fb-u2","fb-p1"]`)
+	mustContain(t, prBody, `{"user_id":"fb-u2","team_name":"fallback-small-team"}`)
+	mustContain(t, prBody, `{"user_id":"fb-p1","team_name":"fallback-pool-team"}`)
+
+	reassignBody := doJSON(t, http.MethodPost, "/pullRequest/reassign", `{
+		"pull_request_id": "fb-pr-1",
+		"old_user_id": "fb-u2"
+	}`, http.StatusConflict)
+	mustContain(t, reassignBody, `"NO_CANDIDATE"`)
+}
@@ -0,0 +1,55 @@
+package integration
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIntegration_MandatoryReviewers_AlwaysAssigned(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "mandatory-team",
+		"members": [
+			{"user_id": "mand-u1", "username": "MandUser1", "is_active": true},
+			{"user_id": "mand-u2", "username": "MandUser2", "is_active": true},
+			{"user_id": "mand-u3", "username": "MandUser3", "is_active": true},
+			{"user_id": "mand-lead", "username": "MandLead", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "mandatory-team",
+		"mandatory_reviewers": ["mand-lead"]
+	}`, http.StatusOK)
+	mustContain(t, body, `"mandatory_reviewers":["mand-lead"]`)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "mand-pr-1",
+		"pull_request_name": "Mandatory",
+		"author_id": "mand-u1"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["mand-lead","mand-u`)
+
+	code, body := doRaw(t, http.MethodPost, "/pullRequest/reassign", `{
+		"pull_request_id": "mand-pr-1",
+		"old_user_id": "mand-lead"
+	}`)
+	if code != http.StatusConflict || !strings.Contains(body, `"MANDATORY_REVIEWER"`) {
+		t.Fatalf("want 409 MANDATORY_REVIEWER, got %d body=%s", code, body)
+	}
+
+	doJSON(t, http.MethodPost, "/users/setIsActive", `{"user_id":"mand-lead","is_active":false}`, http.StatusOK)
+	doJSON(t, http.MethodPost, "/pullRequest/reassign", `{
+		"pull_request_id": "mand-pr-1",
+		"old_user_id": "mand-lead"
+	}`, http.StatusOK)
+
+	prBody = doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "mand-pr-2",
+		"pull_request_name": "Mandatory Inactive",
+		"author_id": "mand-u1"
+	}`, http.StatusCreated)
+	if contains(prBody, `"mand-lead"`) {
+		t.Fatalf("inactive mandatory reviewer should not be assigned, body=%s", prBody)
+	}
+}
@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestIntegration_PairingDiversity_SpreadsReviewersForAuthor(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "pairing-team",
+		"members": [
+			{"user_id": "pair-u1", "username": "PairUser1", "is_active": true},
+			{"user_id": "pair-u2", "username": "PairUser2", "is_active": true},
+			{"user_id": "pair-u3", "username": "PairUser3", "is_active": true},
+			{"user_id": "pair-u4", "username": "PairUser4", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "pairing-team",
+		"reviewers_count": 1,
+		"strategy": "pairing_diversity"
+	}`, http.StatusOK)
+
+	reviewers := []string{"pair-u2", "pair-u3", "pair-u4"}
+	picks := map[string]int{}
+	for i := 1; i <= 9; i++ {
+		body := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+			"pull_request_id": "pair-pr-`+strconv.Itoa(i)+`",
+			"pull_request_name": "Pairing",
+			"author_id": "pair-u1"
+		}`, http.StatusCreated)
+		for _, reviewer := range reviewers {
+			if strings.Contains(body, `"assigned_reviewers":["`+reviewer+`"]`) {
+				picks[reviewer]++
+			}
+		}
+	}
+	for _, reviewer := range reviewers {
+		if picks[reviewer] < 2 || picks[reviewer] > 4 {
+			t.Fatalf("want pairings spread across reviewers, got %v", picks)
+		}
+	}
+
+	body := doJSON(t, http.MethodGet, "/team/pairings?team_name=pairing-team", "", http.StatusOK)
+	for _, reviewer := range reviewers {
+		mustContain(t, body, `"`+reviewer+`":`+strconv.Itoa(picks[reviewer]))
+	}
+}
@@ -0,0 +1,88 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIntegration_TeamSettings_ReviewersCount(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "settings-team",
+		"members": [
+			{"user_id": "set-u1", "username": "SetUser1", "is_active": true},
+			{"user_id": "set-u2", "username": "SetUser2", "is_active": true},
+			{"user_id": "set-u3", "username": "SetUser3", "is_active": true},
+			{"user_id": "set-u4", "username": "SetUser4", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodGet, "/team/settings/get?team_name=settings-team", "", http.StatusOK)
+	mustContain(t, body, `"reviewers_count":2`)
+	mustContain(t, body, `"fallback":"ASSIGN_AVAILABLE"`)
+
+	body = doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "settings-team",
+		"reviewers_count": 3,
+		"strategy": "least_loaded"
+	}`, http.StatusOK)
+	mustContain(t, body, `"reviewers_count":3`)
+	mustContain(t, body, `"strategy":"least_loaded"`)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "set-pr-1",
+		"pull_request_name": "Three Reviewers",
+		"author_id": "set-u1"
+	}`, http.StatusCreated)
+
+	var resp struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.Unmarshal([]byte(prBody), &resp); err != nil {
+		t.Fatalf("decode pr: %v", err)
+	}
+	if len(resp.PR.AssignedReviewers) != 3 {
+		t.Fatalf("want 3 reviewers, got %v", resp.PR.AssignedReviewers)
+	}
+
+	body = doJSON(t, http.MethodPost, "/team/settings/delete", `{"team_name": "settings-team"}`, http.StatusOK)
+	mustContain(t, body, `"reviewers_count":2`)
+}
+
+func TestIntegration_TeamSettings_RejectWhenNotEnoughCandidates(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "settings-reject-team",
+		"members": [
+			{"user_id": "setr-u1", "username": "SetRUser1", "is_active": true},
+			{"user_id": "setr-u2", "username": "SetRUser2", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "settings-reject-team",
+		"fallback": "REJECT"
+	}`, http.StatusOK)
+
+	code, body := doRaw(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "setr-pr-1",
+		"pull_request_name": "Rejected",
+		"author_id": "setr-u1"
+	}`)
+	if code != http.StatusConflict || !strings.Contains(body, `"NOT_ENOUGH_CANDIDATES"`) {
+		t.Fatalf("want 409 NOT_ENOUGH_CANDIDATES, got %d body=%s", code, body)
+	}
+}
+
+func TestIntegration_TeamSettings_InvalidStrategy(t *testing.T) {
+	ensureBackendTeam(t)
+	code, body := doRaw(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "backend",
+		"strategy": "does-not-exist"
+	}`)
+	if code != http.StatusBadRequest || !strings.Contains(body, `"INVALID_ARGUMENT"`) {
+		t.Fatalf("want 400 INVALID_ARGUMENT, got %d body=%s", code, body)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
 	"time"
 
@@ -26,6 +27,10 @@ import (
 	"pr-review/internal/service"
 )
 
+const testAdminToken = "test-admin-token"
+
+var testNow = time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+
 var (
 	httpClient *http.Client
 	baseURL    string
@@ -71,10 +76,34 @@ func TestMain(m *testing.M) {
 	userRepo := repoPostgres.NewUserRepository(db)
 	prRepo := repoPostgres.NewPullRequestRepository(db)
 	teamRepo := repoPostgres.NewTeamRepository(db)
+	rotationRepo := repoPostgres.NewTeamRotationRepository(db)
+	teamSettingsRepo := repoPostgres.NewTeamSettingsRepository(db)
+	outOfOfficeRepo := repoPostgres.NewOutOfOfficeRepository(db)
+	codeOwnersRepo := repoPostgres.NewCodeOwnersRepository(db)
+	historyRepo := repoPostgres.NewAssignmentHistoryRepository(db)
+	exclusionRepo := repoPostgres.NewReviewExclusionRepository(db)
+	reviewRepo := repoPostgres.NewReviewRepository(db)
+	swapOfferRepo := repoPostgres.NewReviewSwapOfferRepository(db)
+	transactor := repoPostgres.NewTransactor(db)
 	svc, err := service.NewService(&service.Config{
-		UserRepo:        userRepo,
-		PullRequestRepo: prRepo,
-		TeamRepo:        teamRepo,
+		UserRepo:         userRepo,
+		PullRequestRepo:  prRepo,
+		TeamRepo:         teamRepo,
+		RotationRepo:     rotationRepo,
+		TeamSettingsRepo: teamSettingsRepo,
+		OutOfOfficeRepo:  outOfOfficeRepo,
+		CodeOwnersRepo:   codeOwnersRepo,
+		HistoryRepo:      historyRepo,
+		ExclusionRepo:    exclusionRepo,
+		ReviewRepo:       reviewRepo,
+		SwapOfferRepo:    swapOfferRepo,
+		Transactor:       transactor,
+		Clock:            func() time.Time { return testNow },
+		Deterministic:    true,
+		TeamStrategies: map[string]string{
+			"least-loaded-team": service.StrategyLeastLoaded,
+			"round-robin-team":  service.StrategyRoundRobin,
+		},
 	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "init service: %v\n", err)
@@ -117,6 +146,7 @@ func minimalConfig(host string, port int) *config.Config {
 		},
 		GracefulTimeout: 5 * time.Second,
 		RateLimit:       config.RateLimitConfig{Requests: 100, Burst: 100},
+		Admin:           config.AdminConfig{Token: testAdminToken},
 	}
 }
 
@@ -170,13 +200,19 @@ func applyMigrations(host string, port int) error {
 		time.Sleep(500 * time.Millisecond)
 	}
 
-	up := filepath.Join(getRepoRoot(), "db", "migration", "000001_init_schema.up.sql")
-	sqlBytes, err := os.ReadFile(up)
+	ups, err := filepath.Glob(filepath.Join(getRepoRoot(), "db", "migration", "*.up.sql"))
 	if err != nil {
 		return err
 	}
-	if _, err := db.Exec(string(sqlBytes)); err != nil {
-		return err
+	sort.Strings(ups)
+	for _, up := range ups {
+		sqlBytes, err := os.ReadFile(up)
+		if err != nil {
+			return err
+		}
+		if _, err := db.Exec(string(sqlBytes)); err != nil {
+			return fmt.Errorf("%s: %w", filepath.Base(up), err)
+		}
 	}
 	return nil
 }
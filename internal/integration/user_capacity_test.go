@@ -0,0 +1,55 @@
+package integration
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIntegration_Capacity_ExcludesReviewersAtLimit(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "capacity-team",
+		"members": [
+			{"user_id": "cap-u1", "username": "CapUser1", "is_active": true},
+			{"user_id": "cap-u2", "username": "CapUser2", "is_active": true},
+			{"user_id": "cap-u3", "username": "CapUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/users/setMaxOpenReviews", `{"user_id":"cap-u2","max_open_reviews":0}`, http.StatusOK)
+	mustContain(t, body, `"max_open_reviews":0`)
+	doJSON(t, http.MethodPost, "/users/setMaxOpenReviews", `{"user_id":"cap-u3","max_open_reviews":1}`, http.StatusOK)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "cap-pr-1",
+		"pull_request_name": "Capacity 1",
+		"author_id": "cap-u1"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"status":"OPEN"`)
+	mustContain(t, prBody, `"assigned_reviewers":["cap-u3"]`)
+
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "capacity-team",
+		"fallback": "UNDERSTAFFED"
+	}`, http.StatusOK)
+	prBody = doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "cap-pr-2",
+		"pull_request_name": "Capacity 2",
+		"author_id": "cap-u1"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"status":"UNDERSTAFFED"`)
+	mustContain(t, prBody, `"assigned_reviewers":[]`)
+
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "capacity-team",
+		"fallback": "REJECT"
+	}`, http.StatusOK)
+	code, body := doRaw(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "cap-pr-3",
+		"pull_request_name": "Capacity 3",
+		"author_id": "cap-u1"
+	}`)
+	if code != http.StatusConflict || !strings.Contains(body, `"NO_CAPACITY"`) {
+		t.Fatalf("want 409 NO_CAPACITY, got %d body=%s", code, body)
+	}
+}
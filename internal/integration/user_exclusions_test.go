@@ -0,0 +1,109 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIntegration_ReviewExclusions_SkipConflictingReviewers(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "exclusion-team",
+		"members": [
+			{"user_id": "ex-u1", "username": "ExUser1", "is_active": true},
+			{"user_id": "ex-u2", "username": "ExUser2", "is_active": true},
+			{"user_id": "ex-u3", "username": "ExUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/users/exclusions/add", `{
+		"reviewer_id": "ex-u2",
+		"author_id": "ex-u1",
+		"symmetric": false,
+		"reason": "pair programming"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"symmetric":false`)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "ex-pr-1",
+		"pull_request_name": "Paired change",
+		"author_id": "ex-u1"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["ex-u3"]`)
+
+	previewBody := doJSON(t, http.MethodPost, "/pullRequest/previewReviewers", `{
+		"pull_request_id": "ex-pr-preview",
+		"pull_request_name": "Paired change",
+		"author_id": "ex-u1"
+	}`, http.StatusOK)
+	mustContain(t, previewBody, `"user_id":"ex-u2","team_name":"exclusion-team","selected":false,"reason":"EXCLUDED"`)
+
+	code, reassignBody := doRaw(t, http.MethodPost, "/pullRequest/reassign", `{
+		"pull_request_id": "ex-pr-1",
+		"old_user_id": "ex-u3"
+	}`)
+	if code != http.StatusConflict {
+		t.Fatalf("want 409 on reassign, got %d body=%s", code, reassignBody)
+	}
+	mustContain(t, reassignBody, `"NO_CANDIDATE"`)
+
+	prBody = doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "ex-pr-2",
+		"pull_request_name": "Reverse direction",
+		"author_id": "ex-u2"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"ex-u1"`)
+
+	listBody := doJSON(t, http.MethodGet, "/users/exclusions/list?user_id=ex-u1", "", http.StatusOK)
+	mustContain(t, listBody, `"reviewer_id":"ex-u2","author_id":"ex-u1"`)
+
+	code, dupBody := doRaw(t, http.MethodPost, "/users/exclusions/add", `{"reviewer_id":"ex-u2","author_id":"ex-u1"}`)
+	if code != http.StatusConflict {
+		t.Fatalf("want 409 on duplicate exclusion, got %d body=%s", code, dupBody)
+	}
+
+	code, selfBody := doRaw(t, http.MethodPost, "/users/exclusions/add", `{"reviewer_id":"ex-u2","author_id":"ex-u2"}`)
+	if code != http.StatusBadRequest || !strings.Contains(selfBody, `"INVALID_ARGUMENT"`) {
+		t.Fatalf("want 400 on self exclusion, got %d body=%s", code, selfBody)
+	}
+}
+
+func TestIntegration_ReviewExclusions_SymmetricByDefault(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "exclusion-sym-team",
+		"members": [
+			{"user_id": "exs-u1", "username": "ExsUser1", "is_active": true},
+			{"user_id": "exs-u2", "username": "ExsUser2", "is_active": true},
+			{"user_id": "exs-u3", "username": "ExsUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/users/exclusions/add", `{
+		"reviewer_id": "exs-u1",
+		"author_id": "exs-u2",
+		"reason": "manager"
+	}`, http.StatusCreated)
+	mustContain(t, body, `"symmetric":true`)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "exs-pr-1",
+		"pull_request_name": "Report change",
+		"author_id": "exs-u1"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["exs-u3"]`)
+
+	var resp struct {
+		Exclusion struct {
+			ID int64 `json:"id"`
+		} `json:"exclusion"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("decode exclusion: %v", err)
+	}
+	doJSON(t, http.MethodPost, "/users/exclusions/delete", fmt.Sprintf(`{"id": %d}`, resp.Exclusion.ID), http.StatusOK)
+
+	listBody := doJSON(t, http.MethodGet, "/users/exclusions/list?user_id=exs-u1", "", http.StatusOK)
+	mustContain(t, listBody, `"exclusions":[]`)
+}
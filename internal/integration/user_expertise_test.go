@@ -0,0 +1,47 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIntegration_Expertise_PrefersMatchingReviewers(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "expertise-team",
+		"members": [
+			{"user_id": "exp-u1", "username": "ExpUser1", "is_active": true},
+			{"user_id": "exp-u2", "username": "ExpUser2", "is_active": true},
+			{"user_id": "exp-u3", "username": "ExpUser3", "is_active": true},
+			{"user_id": "exp-u4", "username": "ExpUser4", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	body := doJSON(t, http.MethodPost, "/users/setExpertise", `{
+		"user_id": "exp-u3",
+		"expertise": ["Go", "postgres", "go"]
+	}`, http.StatusOK)
+	mustContain(t, body, `"expertise":["go","postgres"]`)
+
+	doJSON(t, http.MethodPost, "/users/setExpertise", `{
+		"user_id": "exp-u4",
+		"expertise": ["frontend"]
+	}`, http.StatusOK)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "exp-pr-1",
+		"pull_request_name": "Query tuning",
+		"author_id": "exp-u1",
+		"labels": ["postgres", "go"]
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"labels":["postgres","go"]`)
+	mustContain(t, prBody, `"assigned_reviewers":["exp-u3",`)
+	mustContain(t, prBody, `{"user_id":"exp-u3","team_name":"expertise-team","match_score":2}`)
+
+	prBody = doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "exp-pr-2",
+		"pull_request_name": "Button colors",
+		"author_id": "exp-u1",
+		"labels": ["frontend"]
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["exp-u4",`)
+}
@@ -0,0 +1,57 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestIntegration_OutOfOffice_ExcludesFromAssignment(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "ooo-team",
+		"members": [
+			{"user_id": "ooo-u1", "username": "OooUser1", "is_active": true},
+			{"user_id": "ooo-u2", "username": "OooUser2", "is_active": true},
+			{"user_id": "ooo-u3", "username": "OooUser3", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	startsAt := testNow.Add(-time.Hour).Format(time.RFC3339)
+	endsAt := testNow.Add(24 * time.Hour).Format(time.RFC3339)
+	addBody := doJSON(t, http.MethodPost, "/users/outOfOffice/add", `{
+		"user_id": "ooo-u2",
+		"starts_at": "`+startsAt+`",
+		"ends_at": "`+endsAt+`",
+		"reason": "vacation"
+	}`, http.StatusCreated)
+	mustContain(t, addBody, `"is_active":true`)
+
+	teamBody := doJSON(t, http.MethodGet, "/team/get?team_name=ooo-team", "", http.StatusOK)
+	mustContain(t, teamBody, `"user_id":"ooo-u2","username":"OooUser2","is_active":true,"is_available":false,"out_of_office_until"`)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "ooo-pr-1",
+		"pull_request_name": "Out Of Office",
+		"author_id": "ooo-u1"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["ooo-u3"]`)
+
+	var added struct {
+		Period struct {
+			ID int64 `json:"id"`
+		} `json:"period"`
+	}
+	if err := json.Unmarshal([]byte(addBody), &added); err != nil {
+		t.Fatalf("decode period: %v", err)
+	}
+
+	listBody := doJSON(t, http.MethodGet, "/users/outOfOffice/list?user_id=ooo-u2", "", http.StatusOK)
+	mustContain(t, listBody, `"reason":"vacation"`)
+
+	doJSON(t, http.MethodPost, "/users/outOfOffice/delete", `{"id":`+strconv.FormatInt(added.Period.ID, 10)+`}`, http.StatusOK)
+
+	teamBody = doJSON(t, http.MethodGet, "/team/get?team_name=ooo-team", "", http.StatusOK)
+	mustContain(t, teamBody, `"user_id":"ooo-u2","username":"OooUser2","is_active":true,"is_available":true`)
+}
@@ -0,0 +1,60 @@
+package integration
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIntegration_Seniority_RequiresSeniorReviewer(t *testing.T) {
+	body := doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "seniority-team",
+		"members": [
+			{"user_id": "sen-u1", "username": "SenUser1", "is_active": true, "seniority": "junior"},
+			{"user_id": "sen-u2", "username": "SenUser2", "is_active": true, "seniority": "junior"},
+			{"user_id": "sen-u3", "username": "SenUser3", "is_active": true},
+			{"user_id": "sen-u4", "username": "SenUser4", "is_active": true, "seniority": "senior"}
+		]
+	}`, http.StatusCreated)
+	mustContain(t, body, `"seniority":"middle"`)
+
+	body = doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "seniority-team",
+		"require_senior": true
+	}`, http.StatusOK)
+	mustContain(t, body, `"require_senior":true`)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "sen-pr-1",
+		"pull_request_name": "Junior change",
+		"author_id": "sen-u1"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["sen-u4",`)
+
+	code, body := doRaw(t, http.MethodPost, "/pullRequest/reassign", `{
+		"pull_request_id": "sen-pr-1",
+		"old_user_id": "sen-u4"
+	}`)
+	if code != http.StatusConflict || !strings.Contains(body, `"SENIOR_REQUIRED"`) {
+		t.Fatalf("want 409 SENIOR_REQUIRED, got %d body=%s", code, body)
+	}
+
+	body = doJSON(t, http.MethodPost, "/users/setSeniority", `{
+		"user_id": "sen-u2",
+		"seniority": "senior"
+	}`, http.StatusOK)
+	mustContain(t, body, `"seniority":"senior"`)
+
+	doJSON(t, http.MethodPost, "/pullRequest/reassign", `{
+		"pull_request_id": "sen-pr-1",
+		"old_user_id": "sen-u4"
+	}`, http.StatusOK)
+
+	code, body = doRaw(t, http.MethodPost, "/users/setSeniority", `{
+		"user_id": "sen-u3",
+		"seniority": "principal"
+	}`)
+	if code != http.StatusBadRequest || !strings.Contains(body, `"INVALID_ARGUMENT"`) {
+		t.Fatalf("want 400 INVALID_ARGUMENT, got %d body=%s", code, body)
+	}
+}
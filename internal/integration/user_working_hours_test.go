@@ -0,0 +1,74 @@
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIntegration_WorkingHours_PrefersOnlineReviewers(t *testing.T) {
+	doJSON(t, http.MethodPost, "/team/add", `{
+		"team_name": "working-hours-team",
+		"members": [
+			{"user_id": "wh-u1", "username": "WhUser1", "is_active": true},
+			{"user_id": "wh-u2", "username": "WhUser2", "is_active": true},
+			{"user_id": "wh-u3", "username": "WhUser3", "is_active": true},
+			{"user_id": "wh-u4", "username": "WhUser4", "is_active": true}
+		]
+	}`, http.StatusCreated)
+
+	hour := testNow.Hour()
+	clock := func(h int) string { return fmt.Sprintf("%02d:00", (h+24)%24) }
+
+	body := doJSON(t, http.MethodPost, "/users/setWorkingHours", `{
+		"user_id": "wh-u2",
+		"time_zone": "Europe/Berlin",
+		"work_start": "`+clock(hour-2)+`",
+		"work_end": "`+clock(hour+4)+`"
+	}`, http.StatusOK)
+	mustContain(t, body, `"time_zone":"Europe/Berlin"`)
+
+	for _, id := range []string{"wh-u3", "wh-u4"} {
+		doJSON(t, http.MethodPost, "/users/setWorkingHours", `{
+			"user_id": "`+id+`",
+			"work_start": "`+clock(hour+3)+`",
+			"work_end": "`+clock(hour+5)+`"
+		}`, http.StatusOK)
+	}
+
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "working-hours-team",
+		"reviewers_count": 1,
+		"prefer_working_hours": true
+	}`, http.StatusOK)
+
+	for _, id := range []string{"wh-pr-1", "wh-pr-2", "wh-pr-3"} {
+		prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+			"pull_request_id": "`+id+`",
+			"pull_request_name": "Working hours",
+			"author_id": "wh-u1"
+		}`, http.StatusCreated)
+		mustContain(t, prBody, `"assigned_reviewers":["wh-u2"]`)
+	}
+
+	doJSON(t, http.MethodPost, "/team/settings/set", `{
+		"team_name": "working-hours-team",
+		"reviewers_count": 2
+	}`, http.StatusOK)
+
+	prBody := doJSON(t, http.MethodPost, "/pullRequest/create", `{
+		"pull_request_id": "wh-pr-4",
+		"pull_request_name": "Working hours",
+		"author_id": "wh-u1"
+	}`, http.StatusCreated)
+	mustContain(t, prBody, `"assigned_reviewers":["wh-u2","wh-u`)
+
+	code, body := doRaw(t, http.MethodPost, "/users/setWorkingHours", `{
+		"user_id": "wh-u3",
+		"time_zone": "Mars/Olympus"
+	}`)
+	if code != http.StatusBadRequest || !strings.Contains(body, `"INVALID_ARGUMENT"`) {
+		t.Fatalf("want 400 INVALID_ARGUMENT, got %d body=%s", code, body)
+	}
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+type AssignmentAction string
+
+const (
+	AssignmentAssigned   AssignmentAction = "ASSIGNED"
+	AssignmentUnassigned AssignmentAction = "UNASSIGNED"
+)
+
+type AssignmentReason string
+
+const (
+	ReasonMandatory      AssignmentReason = "MANDATORY"
+	ReasonCodeOwner      AssignmentReason = "CODE_OWNER"
+	ReasonSeniorRequired AssignmentReason = "SENIOR_REQUIRED"
+	ReasonStrategy       AssignmentReason = "STRATEGY"
+	ReasonManual         AssignmentReason = "MANUAL"
+	ReasonClaimed        AssignmentReason = "CLAIMED"
+
+	ReasonAuthor          AssignmentReason = "AUTHOR"
+	ReasonInactive        AssignmentReason = "INACTIVE"
+	ReasonOutOfOffice     AssignmentReason = "OUT_OF_OFFICE"
+	ReasonAlreadyAssigned AssignmentReason = "ALREADY_ASSIGNED"
+	ReasonOverCapacity    AssignmentReason = "OVER_CAPACITY"
+	ReasonExcluded        AssignmentReason = "EXCLUDED"
+	ReasonNotSelected     AssignmentReason = "NOT_SELECTED"
+
+	ReasonReplaced    AssignmentReason = "REPLACED"
+	ReasonDeactivated AssignmentReason = "DEACTIVATED"
+	ReasonClosed      AssignmentReason = "CLOSED"
+)
+
+type AssignmentEvent struct {
+	ID            int64            `db:"id"`
+	PullRequestID int64            `db:"pull_request_id"`
+	AuthorID      string           `db:"author_id"`
+	ReviewerID    string           `db:"reviewer_id"`
+	Action        AssignmentAction `db:"action"`
+	Reason        AssignmentReason `db:"reason"`
+	TeamID        *int64           `db:"team_id"`
+	CreatedAt     *time.Time       `db:"created_at"`
+}
+
+type CandidateExplanation struct {
+	UserID   string
+	TeamName string
+	Selected bool
+	Reason   AssignmentReason
+}
+
+type PairingStat struct {
+	AuthorID   string `db:"author_id"`
+	ReviewerID string `db:"reviewer_id"`
+	Reviews    int64  `db:"reviews"`
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type CodeOwners struct {
+	ID        int64      `db:"id"`
+	TeamID    int64      `db:"team_id"`
+	Version   int        `db:"version"`
+	Content   string     `db:"content"`
+	CreatedAt *time.Time `db:"created_at"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+type OutOfOffice struct {
+	ID       int64     `db:"id"`
+	UserID   string    `db:"user_id"`
+	StartsAt time.Time `db:"starts_at"`
+	EndsAt   time.Time `db:"ends_at"`
+	Reason   string    `db:"reason"`
+	IsActive bool      `db:"-"`
+}
+
+func (o *OutOfOffice) ActiveAt(t time.Time) bool {
+	return !t.Before(o.StartsAt) && t.Before(o.EndsAt)
+}
@@ -9,10 +9,27 @@ import (
 type PullRequestStatus string
 
 const (
-	PRStatusOpen   PullRequestStatus = "OPEN"
-	PRStatusMerged PullRequestStatus = "MERGED"
+	PRStatusOpen         PullRequestStatus = "OPEN"
+	PRStatusUnderstaffed PullRequestStatus = "UNDERSTAFFED"
+	PRStatusMerged       PullRequestStatus = "MERGED"
+	PRStatusDraft        PullRequestStatus = "DRAFT"
+	PRStatusClosed       PullRequestStatus = "CLOSED"
 )
 
+var (
+	OpenStatuses   = []PullRequestStatus{PRStatusOpen, PRStatusUnderstaffed}
+	ReviewStatuses = []PullRequestStatus{PRStatusOpen, PRStatusUnderstaffed, PRStatusMerged}
+)
+
+func (s PullRequestStatus) IsOpen() bool {
+	for _, open := range OpenStatuses {
+		if s == open {
+			return true
+		}
+	}
+	return false
+}
+
 type PullRequest struct {
 	ID            int64             `db:"id"`
 	PullRequestID string            `db:"pull_request_id"`
@@ -20,21 +37,121 @@ type PullRequest struct {
 	AuthorID      string            `db:"author_id"`
 	Status        PullRequestStatus `db:"status"`
 	Reviewers     pq.StringArray    `db:"reviewers"`
+	Labels        pq.StringArray    `db:"labels"`
+	ChangedFiles  pq.StringArray    `db:"changed_files"`
 	CreatedAt     *time.Time        `db:"created_at"`
 	MergedAt      *time.Time        `db:"merged_at"`
+	ForcedMerge   bool              `db:"forced_merge"`
+	MergeBlockers pq.StringArray    `db:"merge_blockers"`
+	Version       int64             `db:"version"`
+	PullRequestSize
+
+	ReviewerSources []ReviewerSource `db:"-"`
+	Reviews         []Review         `db:"-"`
+}
+
+type ReviewerSource struct {
+	UserID     string
+	TeamName   string
+	MatchScore int
+}
+
+type PullRequestSize struct {
+	LinesAdded   *int `db:"lines_added"`
+	LinesRemoved *int `db:"lines_removed"`
+	FilesChanged *int `db:"files_changed"`
+}
+
+func (s PullRequestSize) IsKnown() bool {
+	return s.LinesAdded != nil || s.LinesRemoved != nil || s.FilesChanged != nil
+}
+
+func (s PullRequestSize) Lines() int {
+	lines := 0
+	if s.LinesAdded != nil {
+		lines += *s.LinesAdded
+	}
+	if s.LinesRemoved != nil {
+		lines += *s.LinesRemoved
+	}
+	return lines
+}
+
+func (s PullRequestSize) Files() int {
+	if s.FilesChanged == nil {
+		return 0
+	}
+	return *s.FilesChanged
+}
+
+func (s PullRequestSize) IsValid() bool {
+	for _, v := range []*int{s.LinesAdded, s.LinesRemoved, s.FilesChanged} {
+		if v != nil && *v < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+type PullRequestCreate struct {
+	PullRequestID string
+	Title         string
+	AuthorID      string
+	ChangedFiles  []string
+	Labels        []string
+	Size          PullRequestSize
+	Draft         bool
+}
+
+type PullRequestReassign struct {
+	PullRequestID  string
+	OldUserID      string
+	NewUserID      string
+	AllowCrossTeam bool
+}
+
+type PullRequestClaim struct {
+	PullRequestID  string
+	UserID         string
+	ReplaceUserID  string
+	AllowCrossTeam bool
+}
+
+type PullRequestReviewerChange struct {
+	PullRequestID  string
+	UserID         string
+	AllowCrossTeam bool
+}
+
+type PullRequestEdit struct {
+	PullRequestID    string
+	NewPullRequestID *string
+	Title            *string
+	AuthorID         *string
+	Version          int64
+}
+
+type PullRequestMerge struct {
+	PullRequestID string
+	Force         bool
 }
 
 type PullRequestUpdate struct {
 	ID            int64
 	PullRequestID *string
 	Title         *string
+	AuthorID      *string
 	Status        *PullRequestStatus
 	Reviewers     *[]string
 	MergedAt      *time.Time
+	ForcedMerge   *bool
+	MergeBlockers *[]string
+	Version       *int64
 }
 
 type ListPullRequestFilter struct {
 	Status           *PullRequestStatus
+	Statuses         []PullRequestStatus
 	ReviewerID       *string
 	ReviewersOverlap *[]string
 	Limit            int
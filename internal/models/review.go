@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+type ReviewVerdict string
+
+const (
+	VerdictApproved         ReviewVerdict = "APPROVED"
+	VerdictChangesRequested ReviewVerdict = "CHANGES_REQUESTED"
+	VerdictCommented        ReviewVerdict = "COMMENTED"
+)
+
+func (v ReviewVerdict) IsValid() bool {
+	switch v {
+	case VerdictApproved, VerdictChangesRequested, VerdictCommented:
+		return true
+	}
+	return false
+}
+
+type Review struct {
+	ID            int64         `db:"id"`
+	PullRequestID int64         `db:"pull_request_id"`
+	ReviewerID    string        `db:"reviewer_id"`
+	Verdict       ReviewVerdict `db:"verdict"`
+	Body          string        `db:"body"`
+	CreatedAt     *time.Time    `db:"created_at"`
+}
+
+type ReviewCreate struct {
+	PullRequestID string
+	ReviewerID    string
+	Verdict       ReviewVerdict
+	Body          string
+}
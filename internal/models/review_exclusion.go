@@ -0,0 +1,9 @@
+package models
+
+type ReviewExclusion struct {
+	ID         int64  `db:"id"`
+	ReviewerID string `db:"reviewer_id"`
+	AuthorID   string `db:"author_id"`
+	Symmetric  bool   `db:"symmetric"`
+	Reason     string `db:"reason"`
+}
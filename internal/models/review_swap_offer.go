@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type ReviewSwapOffer struct {
+	ID            int64      `db:"id"`
+	PullRequestID int64      `db:"pull_request_id"`
+	ReviewerID    string     `db:"reviewer_id"`
+	ClaimerID     string     `db:"claimer_id"`
+	CreatedAt     *time.Time `db:"created_at"`
+}
+
+type ReviewSwapOfferCreate struct {
+	PullRequestID string
+	ReviewerID    string
+	ClaimerID     string
+}
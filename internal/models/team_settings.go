@@ -0,0 +1,148 @@
+package models
+
+type AssignmentFallback string
+
+const (
+	FallbackAssignAvailable AssignmentFallback = "ASSIGN_AVAILABLE"
+	FallbackUnderstaffed    AssignmentFallback = "UNDERSTAFFED"
+	FallbackReject          AssignmentFallback = "REJECT"
+)
+
+const DefaultReviewersCount = 2
+
+type TeamSettings struct {
+	TeamID         int64              `db:"team_id"`
+	ReviewersCount int                `db:"reviewers_count"`
+	MinApprovals   int                `db:"min_approvals"`
+	Strategy       string             `db:"strategy"`
+	Fallback       AssignmentFallback `db:"fallback"`
+	FallbackTeams  []Team             `db:"-"`
+
+	MandatoryReviewers []string   `db:"-"`
+	RequireSenior      bool       `db:"require_senior"`
+	PreferWorkingHours bool       `db:"prefer_working_hours"`
+	SizeRules          []SizeRule `db:"-"`
+
+	BlockOnChangesRequested  bool `db:"block_on_changes_requested"`
+	RequireMandatoryApproval bool `db:"require_mandatory_approval"`
+
+	MinReviewers int `db:"min_reviewers"`
+	MaxReviewers int `db:"max_reviewers"`
+}
+
+type SizeRule struct {
+	MaxLines       int `db:"max_lines"`
+	MaxFiles       int `db:"max_files"`
+	ReviewersCount int `db:"reviewers_count"`
+}
+
+func (r SizeRule) Matches(size PullRequestSize) bool {
+	if r.MaxLines > 0 && size.Lines() > r.MaxLines {
+		return false
+	}
+	if r.MaxFiles > 0 && size.Files() > r.MaxFiles {
+		return false
+	}
+	return true
+}
+
+type TeamSettingsUpdate struct {
+	ReviewersCount *int
+	MinApprovals   *int
+	Strategy       *string
+	Fallback       *AssignmentFallback
+	FallbackTeams  *[]string
+
+	MandatoryReviewers *[]string
+	RequireSenior      *bool
+	PreferWorkingHours *bool
+	SizeRules          *[]SizeRule
+
+	BlockOnChangesRequested  *bool
+	RequireMandatoryApproval *bool
+
+	MinReviewers *int
+	MaxReviewers *int
+}
+
+func DefaultTeamSettings(teamID int64) *TeamSettings {
+	return &TeamSettings{
+		TeamID:         teamID,
+		ReviewersCount: DefaultReviewersCount,
+		MinApprovals:   0,
+		Strategy:       "",
+		Fallback:       FallbackAssignAvailable,
+		FallbackTeams:  []Team{},
+
+		MandatoryReviewers: []string{},
+		RequireSenior:      false,
+		PreferWorkingHours: false,
+		SizeRules:          []SizeRule{},
+
+		BlockOnChangesRequested:  true,
+		RequireMandatoryApproval: true,
+
+		MinReviewers: 0,
+		MaxReviewers: 0,
+	}
+}
+
+func (s *TeamSettings) Apply(u TeamSettingsUpdate) {
+	if u.ReviewersCount != nil {
+		s.ReviewersCount = *u.ReviewersCount
+	}
+	if u.MinApprovals != nil {
+		s.MinApprovals = *u.MinApprovals
+	}
+	if u.Strategy != nil {
+		s.Strategy = *u.Strategy
+	}
+	if u.Fallback != nil {
+		s.Fallback = *u.Fallback
+	}
+	if u.MandatoryReviewers != nil {
+		s.MandatoryReviewers = *u.MandatoryReviewers
+	}
+	if u.RequireSenior != nil {
+		s.RequireSenior = *u.RequireSenior
+	}
+	if u.PreferWorkingHours != nil {
+		s.PreferWorkingHours = *u.PreferWorkingHours
+	}
+	if u.SizeRules != nil {
+		s.SizeRules = *u.SizeRules
+	}
+	if u.BlockOnChangesRequested != nil {
+		s.BlockOnChangesRequested = *u.BlockOnChangesRequested
+	}
+	if u.RequireMandatoryApproval != nil {
+		s.RequireMandatoryApproval = *u.RequireMandatoryApproval
+	}
+	if u.MinReviewers != nil {
+		s.MinReviewers = *u.MinReviewers
+	}
+	if u.MaxReviewers != nil {
+		s.MaxReviewers = *u.MaxReviewers
+	}
+}
+
+func (s *TeamSettings) IsMandatoryReviewer(userID string) bool {
+	for _, id := range s.MandatoryReviewers {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *TeamSettings) ReviewersCountFor(size PullRequestSize) int {
+	if !size.IsKnown() {
+		return s.ReviewersCount
+	}
+	for _, rule := range s.SizeRules {
+		if rule.Matches(size) {
+			return rule.ReviewersCount
+		}
+	}
+	return s.ReviewersCount
+}
@@ -1,10 +1,73 @@
 package models
 
+import (
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type Seniority string
+
+const (
+	SeniorityJunior Seniority = "junior"
+	SeniorityMiddle Seniority = "middle"
+	SenioritySenior Seniority = "senior"
+)
+
+func (s Seniority) IsValid() bool {
+	switch s {
+	case SeniorityJunior, SeniorityMiddle, SenioritySenior:
+		return true
+	}
+	return false
+}
+
+const DefaultReviewWeight = 1.0
+
 type User struct {
 	ID       string `db:"id"`
 	Name     string `db:"name"`
 	TeamID   int64  `db:"team_id"`
 	IsActive bool   `db:"is_active"`
+
+	Seniority      Seniority      `db:"seniority"`
+	MaxOpenReviews *int           `db:"max_open_reviews"`
+	Expertise      pq.StringArray `db:"expertise"`
+	TimeZone       string         `db:"time_zone"`
+	WorkStart      *string        `db:"work_start"`
+	WorkEnd        *string        `db:"work_end"`
+	ReviewWeight   float64        `db:"review_weight"`
+
+	OutOfOfficeUntil *time.Time `db:"-"`
+}
+
+func (u *User) ReviewLimit(defaultLimit int) (int, bool) {
+	if u.MaxOpenReviews != nil {
+		return *u.MaxOpenReviews, true
+	}
+	return defaultLimit, defaultLimit > 0
+}
+
+func (u *User) MatchScore(labels []string) int {
+	score := 0
+	for _, label := range labels {
+		for _, tag := range u.Expertise {
+			if strings.EqualFold(tag, label) {
+				score++
+				break
+			}
+		}
+	}
+	return score
+}
+
+func (u *User) IsSenior() bool {
+	return u.Seniority == SenioritySenior
+}
+
+func (u *User) IsAvailable() bool {
+	return u.IsActive && u.OutOfOfficeUntil == nil
 }
 
 type UserUpdate struct {
@@ -12,6 +75,13 @@ type UserUpdate struct {
 	Name     *string
 	TeamID   *int64
 	IsActive *bool
+
+	Seniority           *Seniority
+	MaxOpenReviews      *int
+	ClearMaxOpenReviews bool
+	Expertise           *[]string
+	WorkingHours        *WorkingHours
+	ReviewWeight        *float64
 }
 
 type ListUserFilter struct {
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+const DefaultTimeZone = "UTC"
+
+type WorkingHours struct {
+	TimeZone string
+	Start    *string
+	End      *string
+}
+
+func ParseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (u *User) IsWorkingAt(at time.Time) bool {
+	if u.WorkStart == nil || u.WorkEnd == nil {
+		return true
+	}
+
+	start, err := ParseClock(*u.WorkStart)
+	if err != nil {
+		return true
+	}
+	end, err := ParseClock(*u.WorkEnd)
+	if err != nil {
+		return true
+	}
+
+	loc, err := time.LoadLocation(u.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	local := at.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+
+	if start <= end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
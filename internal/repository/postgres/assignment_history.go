@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"pr-review/internal/models"
+)
+
+const (
+	selectRecentPairingsQuery = `
+		SELECT reviewer_id, COUNT(DISTINCT pull_request_id) AS reviews
+		FROM pr_review.assignment_history
+		WHERE action = 'ASSIGNED' AND author_id = $1 AND pull_request_id IN (
+			SELECT id
+			FROM pr_review.pull_request
+			WHERE author_id = $1
+			ORDER BY id DESC
+			LIMIT $2
+		)
+		GROUP BY reviewer_id`
+
+	selectPairingMatrixQuery = `
+		SELECT author_id, reviewer_id, COUNT(DISTINCT pull_request_id) AS reviews
+		FROM pr_review.assignment_history
+		WHERE action = 'ASSIGNED' AND author_id = ANY($1::text[])
+		GROUP BY author_id, reviewer_id
+		ORDER BY author_id, reviewer_id`
+
+	selectAssignmentHistoryByPullRequestQuery = `
+		SELECT id, pull_request_id, author_id, reviewer_id, action, reason, team_id, created_at
+		FROM pr_review.assignment_history
+		WHERE pull_request_id = $1
+		ORDER BY id`
+
+	selectAssignmentSourceTeamsQuery = `
+		SELECT DISTINCT ON (reviewer_id) reviewer_id, team_id
+		FROM pr_review.assignment_history
+		WHERE pull_request_id = $1 AND action = 'ASSIGNED' AND team_id IS NOT NULL
+		ORDER BY reviewer_id, id DESC`
+)
+
+type AssignmentHistoryRepository struct {
+	db *sqlx.DB
+}
+
+func NewAssignmentHistoryRepository(db *sqlx.DB) *AssignmentHistoryRepository {
+	return &AssignmentHistoryRepository{db: db}
+}
+
+func (r *AssignmentHistoryRepository) Record(ctx context.Context, events []models.AssignmentEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	builder := newQueryBuilder().
+		Insert("pr_review.assignment_history").
+		Columns("pull_request_id", "author_id", "reviewer_id", "action", "reason", "team_id")
+	for _, e := range events {
+		builder = builder.Values(e.PullRequestID, e.AuthorID, e.ReviewerID, e.Action, e.Reason, e.TeamID)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert assignment history query: %w", err)
+	}
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert assignment history: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AssignmentHistoryRepository) ListByPullRequest(ctx context.Context, pullRequestID int64) ([]*models.AssignmentEvent, error) {
+	var out []*models.AssignmentEvent
+	if err := conn(ctx, r.db).SelectContext(ctx, &out, selectAssignmentHistoryByPullRequestQuery, pullRequestID); err != nil {
+		return nil, fmt.Errorf("select assignment history: %w", err)
+	}
+
+	if out == nil {
+		out = []*models.AssignmentEvent{}
+	}
+
+	return out, nil
+}
+
+func (r *AssignmentHistoryRepository) SourceTeams(ctx context.Context, pullRequestID int64) (map[string]int64, error) {
+	rows, err := conn(ctx, r.db).QueryxContext(ctx, selectAssignmentSourceTeamsQuery, pullRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("select assignment source teams: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[string]int64)
+	for rows.Next() {
+		var reviewerID string
+		var teamID int64
+		if err := rows.Scan(&reviewerID, &teamID); err != nil {
+			return nil, fmt.Errorf("scan assignment source teams: %w", err)
+		}
+		out[reviewerID] = teamID
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows assignment source teams: %w", err)
+	}
+
+	return out, nil
+}
+
+func (r *AssignmentHistoryRepository) CountRecentPairings(ctx context.Context, authorID string, lastPRs int) ([]models.UserAssignmentStat, error) {
+	rows, err := conn(ctx, r.db).QueryxContext(ctx, selectRecentPairingsQuery, authorID, lastPRs)
+	if err != nil {
+		return nil, fmt.Errorf("select recent pairings: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]models.UserAssignmentStat, 0)
+	for rows.Next() {
+		var userID string
+		var cnt int64
+		if err := rows.Scan(&userID, &cnt); err != nil {
+			return nil, fmt.Errorf("scan recent pairings: %w", err)
+		}
+		out = append(out, models.UserAssignmentStat{
+			UserID:      userID,
+			Assignments: cnt,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows recent pairings: %w", err)
+	}
+
+	return out, nil
+}
+
+func (r *AssignmentHistoryRepository) PairingMatrix(ctx context.Context, authorIDs []string) ([]models.PairingStat, error) {
+	out := make([]models.PairingStat, 0)
+	if len(authorIDs) == 0 {
+		return out, nil
+	}
+
+	if err := conn(ctx, r.db).SelectContext(ctx, &out, selectPairingMatrixQuery, pq.StringArray(authorIDs)); err != nil {
+		return nil, fmt.Errorf("select pairing matrix: %w", err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	domainerrors "pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+const (
+	lockCodeOwnersTeamQuery = `
+		SELECT id
+		FROM pr_review.team
+		WHERE id = $1
+		FOR UPDATE`
+
+	insertCodeOwnersQuery = `
+		INSERT INTO pr_review.team_codeowners (team_id, version, content)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2
+		FROM pr_review.team_codeowners
+		WHERE team_id = $1
+		RETURNING id, version, created_at`
+
+	selectLatestCodeOwnersQuery = `
+		SELECT id, team_id, version, content, created_at
+		FROM pr_review.team_codeowners
+		WHERE team_id = $1
+		ORDER BY version DESC
+		LIMIT 1`
+
+	selectCodeOwnersByVersionQuery = `
+		SELECT id, team_id, version, content, created_at
+		FROM pr_review.team_codeowners
+		WHERE team_id = $1 AND version = $2`
+)
+
+type CodeOwnersRepository struct {
+	db *sqlx.DB
+}
+
+func NewCodeOwnersRepository(db *sqlx.DB) *CodeOwnersRepository {
+	return &CodeOwnersRepository{db: db}
+}
+
+func (r *CodeOwnersRepository) Create(ctx context.Context, c *models.CodeOwners) error {
+	if c == nil {
+		return fmt.Errorf("codeowners cannot be nil")
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer rollbackTransaction(tx)
+
+	var teamID int64
+	if err := tx.QueryRowxContext(ctx, lockCodeOwnersTeamQuery, c.TeamID).Scan(&teamID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domainerrors.NewNotFoundError(fmt.Sprintf("team %d not found", c.TeamID))
+		}
+		return fmt.Errorf("lock codeowners team: %w", err)
+	}
+
+	err = tx.QueryRowxContext(ctx, insertCodeOwnersQuery, c.TeamID, c.Content).Scan(&c.ID, &c.Version, &c.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insert codeowners: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CodeOwnersRepository) GetLatest(ctx context.Context, teamID int64) (*models.CodeOwners, error) {
+	var c models.CodeOwners
+
+	if err := conn(ctx, r.db).GetContext(ctx, &c, selectLatestCodeOwnersQuery, teamID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domainerrors.NewNotFoundError(fmt.Sprintf("codeowners for team %d not found", teamID))
+		}
+		return nil, fmt.Errorf("get latest codeowners: %w", err)
+	}
+
+	return &c, nil
+}
+
+func (r *CodeOwnersRepository) GetByVersion(ctx context.Context, teamID int64, version int) (*models.CodeOwners, error) {
+	var c models.CodeOwners
+
+	if err := conn(ctx, r.db).GetContext(ctx, &c, selectCodeOwnersByVersionQuery, teamID, version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domainerrors.NewNotFoundError(fmt.Sprintf("codeowners version %d for team %d not found", version, teamID))
+		}
+		return nil, fmt.Errorf("get codeowners by version: %w", err)
+	}
+
+	return &c, nil
+}
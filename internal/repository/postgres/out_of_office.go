@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"pr-review/internal/models"
+)
+
+const (
+	insertOutOfOfficeQuery = `
+		INSERT INTO pr_review.user_out_of_office (user_id, starts_at, ends_at, reason)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id`
+
+	selectOutOfOfficeByIDQuery = `
+		SELECT id, user_id, starts_at, ends_at, reason
+		FROM pr_review.user_out_of_office
+		WHERE id = $1`
+
+	selectOutOfOfficeByUserQuery = `
+		SELECT id, user_id, starts_at, ends_at, reason
+		FROM pr_review.user_out_of_office
+		WHERE user_id = $1
+		ORDER BY starts_at`
+
+	selectActiveOutOfOfficeQuery = `
+		SELECT id, user_id, starts_at, ends_at, reason
+		FROM pr_review.user_out_of_office
+		WHERE user_id = ANY($1::text[]) AND starts_at <= $2 AND ends_at > $2`
+
+	deleteOutOfOfficeQuery = `
+		DELETE FROM pr_review.user_out_of_office
+		WHERE id = $1`
+)
+
+type OutOfOfficeRepository struct {
+	db *sqlx.DB
+}
+
+func NewOutOfOfficeRepository(db *sqlx.DB) *OutOfOfficeRepository {
+	return &OutOfOfficeRepository{db: db}
+}
+
+func (r *OutOfOfficeRepository) Create(ctx context.Context, o *models.OutOfOffice) error {
+	if o == nil {
+		return fmt.Errorf("out of office period cannot be nil")
+	}
+
+	if err := conn(ctx, r.db).QueryRowxContext(ctx, insertOutOfOfficeQuery, o.UserID, o.StartsAt, o.EndsAt, o.Reason).Scan(&o.ID); err != nil {
+		return fmt.Errorf("insert out of office period: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OutOfOfficeRepository) GetByID(ctx context.Context, id int64) (*models.OutOfOffice, error) {
+	var o models.OutOfOffice
+
+	if err := conn(ctx, r.db).GetContext(ctx, &o, selectOutOfOfficeByIDQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("out of office period with id %d not found", id)
+		}
+		return nil, fmt.Errorf("get out of office period by id: %w", err)
+	}
+
+	return &o, nil
+}
+
+func (r *OutOfOfficeRepository) ListByUserID(ctx context.Context, userID string) ([]*models.OutOfOffice, error) {
+	var out []*models.OutOfOffice
+	if err := conn(ctx, r.db).SelectContext(ctx, &out, selectOutOfOfficeByUserQuery, userID); err != nil {
+		return nil, fmt.Errorf("select out of office periods: %w", err)
+	}
+
+	if out == nil {
+		out = []*models.OutOfOffice{}
+	}
+
+	return out, nil
+}
+
+func (r *OutOfOfficeRepository) ListActive(ctx context.Context, userIDs []string, at time.Time) ([]*models.OutOfOffice, error) {
+	out := []*models.OutOfOffice{}
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+
+	if err := conn(ctx, r.db).SelectContext(ctx, &out, selectActiveOutOfOfficeQuery, pq.StringArray(userIDs), at); err != nil {
+		return nil, fmt.Errorf("select active out of office periods: %w", err)
+	}
+
+	return out, nil
+}
+
+func (r *OutOfOfficeRepository) Delete(ctx context.Context, id int64) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, deleteOutOfOfficeQuery, id)
+	if err != nil {
+		return fmt.Errorf("delete out of office period: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("out of office period with id %d not found", id)
+	}
+
+	return nil
+}
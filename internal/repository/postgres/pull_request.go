@@ -20,17 +20,24 @@ const (
 			title,
 			author_id,
 			status,
-			reviewers
-		) VALUES ($1, $2, $3, $4, $5)
-		RETURNING id`
+			reviewers,
+			labels,
+			changed_files,
+			lines_added,
+			lines_removed,
+			files_changed
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, version`
 
 	selectPullRequestByIDQuery = `
-		SELECT id, pull_request_id, title, author_id, status, reviewers, created_at, merged_at
+		SELECT id, pull_request_id, title, author_id, status, reviewers, labels, changed_files, created_at, merged_at,
+			forced_merge, merge_blockers, lines_added, lines_removed, files_changed, version
 		FROM pr_review.pull_request
 		WHERE id = $1`
 
 	selectPullRequestByStringIDQuery = `
-		SELECT id, pull_request_id, title, author_id, status, reviewers, created_at, merged_at
+		SELECT id, pull_request_id, title, author_id, status, reviewers, labels, changed_files, created_at, merged_at,
+			forced_merge, merge_blockers, lines_added, lines_removed, files_changed, version
 		FROM pr_review.pull_request
 		WHERE pull_request_id = $1`
 
@@ -39,12 +46,24 @@ const (
 		FROM (
 			SELECT unnest(reviewers) AS user_id
 			FROM pr_review.pull_request
+			WHERE status NOT IN ('DRAFT', 'CLOSED')
 		) t
 		GROUP BY user_id`
 
+	selectOpenReviewsByUsersQuery = `
+		SELECT user_id, COUNT(*) AS assignments
+		FROM (
+			SELECT unnest(reviewers) AS user_id
+			FROM pr_review.pull_request
+			WHERE status IN ('OPEN', 'UNDERSTAFFED') AND reviewers && $1::text[]
+		) t
+		WHERE user_id = ANY($1::text[])
+		GROUP BY user_id`
+
 	selectAssignmentsPerPRQuery = `
 		SELECT pull_request_id, COALESCE(cardinality(reviewers), 0) AS reviewers_count
-		FROM pr_review.pull_request`
+		FROM pr_review.pull_request
+		WHERE status NOT IN ('DRAFT', 'CLOSED')`
 )
 
 type PullRequestRepository struct {
@@ -60,13 +79,7 @@ func (r *PullRequestRepository) Create(ctx context.Context, pr *models.PullReque
 		return fmt.Errorf("pull request cannot be nil")
 	}
 
-	tx, err := r.db.BeginTxx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("begin transaction: %w", err)
-	}
-	defer rollbackTransaction(tx)
-
-	err = tx.QueryRowxContext(
+	err := conn(ctx, r.db).QueryRowxContext(
 		ctx,
 		insertPullRequestQuery,
 		pr.PullRequestID,
@@ -74,22 +87,23 @@ func (r *PullRequestRepository) Create(ctx context.Context, pr *models.PullReque
 		pr.AuthorID,
 		pr.Status,
 		pr.Reviewers,
-	).Scan(&pr.ID)
+		pr.Labels,
+		pr.ChangedFiles,
+		pr.LinesAdded,
+		pr.LinesRemoved,
+		pr.FilesChanged,
+	).Scan(&pr.ID, &pr.Version)
 	if err != nil {
 		return fmt.Errorf("insert pull request: %w", err)
 	}
 
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("commit transaction: %w", err)
-	}
-
 	return nil
 }
 
 func (r *PullRequestRepository) GetByID(ctx context.Context, prID int64) (*models.PullRequest, error) {
 	var pr models.PullRequest
 
-	if err := r.db.GetContext(ctx, &pr, selectPullRequestByIDQuery, prID); err != nil {
+	if err := conn(ctx, r.db).GetContext(ctx, &pr, selectPullRequestByIDQuery, prID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("pull request with id %d not found", prID)
 		}
@@ -113,6 +127,9 @@ func (r *PullRequestRepository) Update(ctx context.Context, u models.PullRequest
 	if u.Title != nil {
 		builder = builder.Set("title", *u.Title)
 	}
+	if u.AuthorID != nil {
+		builder = builder.Set("author_id", *u.AuthorID)
+	}
 	if u.Status != nil {
 		builder = builder.Set("status", *u.Status)
 	}
@@ -122,15 +139,26 @@ func (r *PullRequestRepository) Update(ctx context.Context, u models.PullRequest
 	if u.MergedAt != nil {
 		builder = builder.Set("merged_at", *u.MergedAt)
 	}
+	if u.ForcedMerge != nil {
+		builder = builder.Set("forced_merge", *u.ForcedMerge)
+	}
+	if u.MergeBlockers != nil {
+		builder = builder.Set("merge_blockers", pq.StringArray(*u.MergeBlockers))
+	}
 
-	builder = builder.Where(squirrel.Eq{"id": u.ID})
+	builder = builder.
+		Set("version", squirrel.Expr("version + 1")).
+		Where(squirrel.Eq{"id": u.ID})
+	if u.Version != nil {
+		builder = builder.Where(squirrel.Eq{"version": *u.Version})
+	}
 
 	query, args, err := builder.ToSql()
 	if err != nil {
 		return fmt.Errorf("build update pull request query: %w", err)
 	}
 
-	res, err := r.db.ExecContext(ctx, query, args...)
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("exec update pull request: %w", err)
 	}
@@ -140,6 +168,9 @@ func (r *PullRequestRepository) Update(ctx context.Context, u models.PullRequest
 		return fmt.Errorf("get rows affected: %w", err)
 	}
 	if rows == 0 {
+		if u.Version != nil {
+			return fmt.Errorf("pull request with id %d: version conflict", u.ID)
+		}
 		return fmt.Errorf("pull request with id %d not found", u.ID)
 	}
 
@@ -148,13 +179,18 @@ func (r *PullRequestRepository) Update(ctx context.Context, u models.PullRequest
 
 func (r *PullRequestRepository) List(ctx context.Context, filter models.ListPullRequestFilter) ([]*models.PullRequest, error) {
 	builder := newQueryBuilder().
-		Select("id", "pull_request_id", "title", "author_id", "status", "reviewers", "created_at", "merged_at").
+		Select("id", "pull_request_id", "title", "author_id", "status", "reviewers", "labels", "changed_files", "created_at", "merged_at",
+			"forced_merge", "merge_blockers", "lines_added", "lines_removed", "files_changed", "version").
 		From("pr_review.pull_request")
 
 	if filter.Status != nil {
 		builder = builder.Where(squirrel.Eq{"status": *filter.Status})
 	}
 
+	if len(filter.Statuses) > 0 {
+		builder = builder.Where(squirrel.Eq{"status": filter.Statuses})
+	}
+
 	if filter.ReviewerID != nil && *filter.ReviewerID != "" {
 		builder = builder.Where(squirrel.Expr("? = ANY(reviewers)", *filter.ReviewerID))
 	}
@@ -177,7 +213,7 @@ func (r *PullRequestRepository) List(ctx context.Context, filter models.ListPull
 	}
 
 	var prs []*models.PullRequest
-	if err = r.db.SelectContext(ctx, &prs, query, args...); err != nil {
+	if err = conn(ctx, r.db).SelectContext(ctx, &prs, query, args...); err != nil {
 		return nil, fmt.Errorf("select pull requests: %w", err)
 	}
 
@@ -191,7 +227,7 @@ func (r *PullRequestRepository) List(ctx context.Context, filter models.ListPull
 func (r *PullRequestRepository) GetByStringID(ctx context.Context, prID string) (*models.PullRequest, error) {
 	var pr models.PullRequest
 
-	if err := r.db.GetContext(ctx, &pr, selectPullRequestByStringIDQuery, prID); err != nil {
+	if err := conn(ctx, r.db).GetContext(ctx, &pr, selectPullRequestByStringIDQuery, prID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("pull request with id %s not found", prID)
 		}
@@ -202,7 +238,7 @@ func (r *PullRequestRepository) GetByStringID(ctx context.Context, prID string)
 }
 
 func (r *PullRequestRepository) StatsAssignmentsByUser(ctx context.Context) ([]models.UserAssignmentStat, error) {
-	rows, err := r.db.QueryxContext(ctx, selectAssignmentsByUserQuery)
+	rows, err := conn(ctx, r.db).QueryxContext(ctx, selectAssignmentsByUserQuery)
 	if err != nil {
 		return nil, fmt.Errorf("select assignments by user: %w", err)
 	}
@@ -226,8 +262,38 @@ func (r *PullRequestRepository) StatsAssignmentsByUser(ctx context.Context) ([]m
 	return out, nil
 }
 
+func (r *PullRequestRepository) CountOpenReviewsByUsers(ctx context.Context, userIDs []string) ([]models.UserAssignmentStat, error) {
+	out := make([]models.UserAssignmentStat, 0, len(userIDs))
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := conn(ctx, r.db).QueryxContext(ctx, selectOpenReviewsByUsersQuery, pq.StringArray(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("select open reviews by users: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID string
+		var cnt int64
+		if err := rows.Scan(&userID, &cnt); err != nil {
+			return nil, fmt.Errorf("scan open reviews by users: %w", err)
+		}
+		out = append(out, models.UserAssignmentStat{
+			UserID:      userID,
+			Assignments: cnt,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows open reviews by users: %w", err)
+	}
+
+	return out, nil
+}
+
 func (r *PullRequestRepository) StatsReviewersPerPR(ctx context.Context) ([]models.PRReviewersStat, error) {
-	rows, err := r.db.QueryxContext(ctx, selectAssignmentsPerPRQuery)
+	rows, err := conn(ctx, r.db).QueryxContext(ctx, selectAssignmentsPerPRQuery)
 	if err != nil {
 		return nil, fmt.Errorf("select reviewers per pr: %w", err)
 	}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"pr-review/internal/models"
+)
+
+const (
+	insertReviewQuery = `
+		INSERT INTO pr_review.review_verdict (pull_request_id, reviewer_id, verdict, body)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at`
+
+	selectLatestReviewsQuery = `
+		SELECT DISTINCT ON (v.pull_request_id, v.reviewer_id) v.id, v.pull_request_id, v.reviewer_id, v.verdict, v.body, v.created_at
+		FROM pr_review.review_verdict v
+		LEFT JOIN (
+			SELECT pull_request_id, reviewer_id, MAX(created_at) AS assigned_at
+			FROM pr_review.assignment_history
+			WHERE pull_request_id = ANY($1::bigint[]) AND action = 'ASSIGNED'
+			GROUP BY pull_request_id, reviewer_id
+		) a ON a.pull_request_id = v.pull_request_id AND a.reviewer_id = v.reviewer_id
+		WHERE v.pull_request_id = ANY($1::bigint[])
+			AND (a.assigned_at IS NULL OR v.created_at >= a.assigned_at)
+		ORDER BY v.pull_request_id, v.reviewer_id, v.id DESC`
+)
+
+type ReviewRepository struct {
+	db *sqlx.DB
+}
+
+func NewReviewRepository(db *sqlx.DB) *ReviewRepository {
+	return &ReviewRepository{db: db}
+}
+
+func (r *ReviewRepository) Create(ctx context.Context, review *models.Review) error {
+	if review == nil {
+		return fmt.Errorf("review cannot be nil")
+	}
+
+	err := conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		insertReviewQuery,
+		review.PullRequestID,
+		review.ReviewerID,
+		review.Verdict,
+		review.Body,
+	).Scan(&review.ID, &review.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insert review: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReviewRepository) ListLatest(ctx context.Context, pullRequestIDs []int64) ([]*models.Review, error) {
+	out := []*models.Review{}
+	if len(pullRequestIDs) == 0 {
+		return out, nil
+	}
+
+	if err := conn(ctx, r.db).SelectContext(ctx, &out, selectLatestReviewsQuery, pq.Int64Array(pullRequestIDs)); err != nil {
+		return nil, fmt.Errorf("select latest reviews: %w", err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"pr-review/internal/models"
+)
+
+const (
+	insertReviewExclusionQuery = `
+		INSERT INTO pr_review.review_exclusion (reviewer_id, author_id, symmetric, reason)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id`
+
+	selectReviewExclusionByIDQuery = `
+		SELECT id, reviewer_id, author_id, symmetric, reason
+		FROM pr_review.review_exclusion
+		WHERE id = $1`
+
+	selectReviewExclusionsByUserQuery = `
+		SELECT id, reviewer_id, author_id, symmetric, reason
+		FROM pr_review.review_exclusion
+		WHERE reviewer_id = $1 OR author_id = $1
+		ORDER BY id`
+
+	selectExcludedReviewersQuery = `
+		SELECT reviewer_id AS user_id
+		FROM pr_review.review_exclusion
+		WHERE author_id = $1
+		UNION
+		SELECT author_id AS user_id
+		FROM pr_review.review_exclusion
+		WHERE reviewer_id = $1 AND symmetric`
+
+	deleteReviewExclusionQuery = `
+		DELETE FROM pr_review.review_exclusion
+		WHERE id = $1`
+)
+
+type ReviewExclusionRepository struct {
+	db *sqlx.DB
+}
+
+func NewReviewExclusionRepository(db *sqlx.DB) *ReviewExclusionRepository {
+	return &ReviewExclusionRepository{db: db}
+}
+
+func (r *ReviewExclusionRepository) Create(ctx context.Context, e *models.ReviewExclusion) error {
+	if e == nil {
+		return fmt.Errorf("review exclusion cannot be nil")
+	}
+
+	if err := conn(ctx, r.db).QueryRowxContext(ctx, insertReviewExclusionQuery, e.ReviewerID, e.AuthorID, e.Symmetric, e.Reason).Scan(&e.ID); err != nil {
+		return fmt.Errorf("insert review exclusion: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReviewExclusionRepository) GetByID(ctx context.Context, id int64) (*models.ReviewExclusion, error) {
+	var e models.ReviewExclusion
+
+	if err := conn(ctx, r.db).GetContext(ctx, &e, selectReviewExclusionByIDQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("review exclusion with id %d not found", id)
+		}
+		return nil, fmt.Errorf("get review exclusion by id: %w", err)
+	}
+
+	return &e, nil
+}
+
+func (r *ReviewExclusionRepository) ListByUserID(ctx context.Context, userID string) ([]*models.ReviewExclusion, error) {
+	var out []*models.ReviewExclusion
+	if err := conn(ctx, r.db).SelectContext(ctx, &out, selectReviewExclusionsByUserQuery, userID); err != nil {
+		return nil, fmt.Errorf("select review exclusions: %w", err)
+	}
+
+	if out == nil {
+		out = []*models.ReviewExclusion{}
+	}
+
+	return out, nil
+}
+
+func (r *ReviewExclusionRepository) ExcludedReviewers(ctx context.Context, authorID string) ([]string, error) {
+	var out []string
+	if err := conn(ctx, r.db).SelectContext(ctx, &out, selectExcludedReviewersQuery, authorID); err != nil {
+		return nil, fmt.Errorf("select excluded reviewers: %w", err)
+	}
+
+	if out == nil {
+		out = []string{}
+	}
+
+	return out, nil
+}
+
+func (r *ReviewExclusionRepository) Delete(ctx context.Context, id int64) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, deleteReviewExclusionQuery, id)
+	if err != nil {
+		return fmt.Errorf("delete review exclusion: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("review exclusion with id %d not found", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"pr-review/internal/models"
+)
+
+const (
+	upsertReviewSwapOfferQuery = `
+		INSERT INTO pr_review.review_swap_offer (pull_request_id, reviewer_id, claimer_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (pull_request_id, reviewer_id, claimer_id) DO UPDATE SET
+			created_at = CURRENT_TIMESTAMP
+		RETURNING id, created_at`
+
+	deleteReviewSwapOfferQuery = `
+		DELETE FROM pr_review.review_swap_offer
+		WHERE pull_request_id = $1 AND reviewer_id = $2 AND claimer_id = $3
+		RETURNING id`
+)
+
+type ReviewSwapOfferRepository struct {
+	db *sqlx.DB
+}
+
+func NewReviewSwapOfferRepository(db *sqlx.DB) *ReviewSwapOfferRepository {
+	return &ReviewSwapOfferRepository{db: db}
+}
+
+func (r *ReviewSwapOfferRepository) Create(ctx context.Context, o *models.ReviewSwapOffer) error {
+	if o == nil {
+		return fmt.Errorf("review swap offer cannot be nil")
+	}
+
+	err := conn(ctx, r.db).QueryRowxContext(ctx, upsertReviewSwapOfferQuery, o.PullRequestID, o.ReviewerID, o.ClaimerID).Scan(&o.ID, &o.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insert review swap offer: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReviewSwapOfferRepository) Consume(ctx context.Context, pullRequestID int64, reviewerID, claimerID string) (bool, error) {
+	var id int64
+	if err := conn(ctx, r.db).GetContext(ctx, &id, deleteReviewSwapOfferQuery, pullRequestID, reviewerID, claimerID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("consume review swap offer: %w", err)
+	}
+
+	return true, nil
+}
@@ -41,7 +41,7 @@ func (r *TeamRepository) Create(ctx context.Context, team *models.Team) error {
 		return fmt.Errorf("team cannot be nil")
 	}
 
-	if err := r.db.QueryRowxContext(ctx, insertTeamQuery, team.Name).Scan(&team.ID); err != nil {
+	if err := conn(ctx, r.db).QueryRowxContext(ctx, insertTeamQuery, team.Name).Scan(&team.ID); err != nil {
 		return fmt.Errorf("insert team: %w", err)
 	}
 
@@ -51,7 +51,7 @@ func (r *TeamRepository) Create(ctx context.Context, team *models.Team) error {
 func (r *TeamRepository) GetByID(ctx context.Context, teamID int64) (*models.Team, error) {
 	var team models.Team
 
-	if err := r.db.GetContext(ctx, &team, selectTeamByIDQuery, teamID); err != nil {
+	if err := conn(ctx, r.db).GetContext(ctx, &team, selectTeamByIDQuery, teamID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("team with id %d not found", teamID)
 		}
@@ -64,7 +64,7 @@ func (r *TeamRepository) GetByID(ctx context.Context, teamID int64) (*models.Tea
 func (r *TeamRepository) GetByName(ctx context.Context, name string) (*models.Team, error) {
 	var team models.Team
 
-	if err := r.db.GetContext(ctx, &team, selectTeamByNameQuery, name); err != nil {
+	if err := conn(ctx, r.db).GetContext(ctx, &team, selectTeamByNameQuery, name); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("team with name %s not found", name)
 		}
@@ -87,7 +87,7 @@ func (r *TeamRepository) List(ctx context.Context, filter models.ListTeamFilter)
 	}
 
 	var teams []*models.Team
-	if err = r.db.SelectContext(ctx, &teams, query, args...); err != nil {
+	if err = conn(ctx, r.db).SelectContext(ctx, &teams, query, args...); err != nil {
 		return nil, fmt.Errorf("select teams list: %w", err)
 	}
 
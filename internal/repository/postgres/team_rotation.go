@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	selectTeamRotationQuery = `
+		SELECT COALESCE(last_user_id, '')
+		FROM pr_review.team_rotation
+		WHERE team_id = $1`
+
+	ensureTeamRotationQuery = `
+		INSERT INTO pr_review.team_rotation (team_id)
+		VALUES ($1)
+		ON CONFLICT (team_id) DO NOTHING`
+
+	lockTeamRotationQuery = `
+		SELECT COALESCE(last_user_id, '')
+		FROM pr_review.team_rotation
+		WHERE team_id = $1
+		FOR UPDATE`
+
+	upsertTeamRotationQuery = `
+		INSERT INTO pr_review.team_rotation (team_id, last_user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (team_id) DO UPDATE SET
+			last_user_id = EXCLUDED.last_user_id,
+			updated_at = CURRENT_TIMESTAMP`
+)
+
+type TeamRotationRepository struct {
+	db *sqlx.DB
+}
+
+func NewTeamRotationRepository(db *sqlx.DB) *TeamRotationRepository {
+	return &TeamRotationRepository{db: db}
+}
+
+func (r *TeamRotationRepository) Peek(ctx context.Context, teamID int64) (string, error) {
+	if tx, ok := txFromContext(ctx); ok {
+		return r.lock(ctx, tx, teamID)
+	}
+
+	var cursor string
+	if err := conn(ctx, r.db).GetContext(ctx, &cursor, selectTeamRotationQuery, teamID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get team rotation: %w", err)
+	}
+
+	return cursor, nil
+}
+
+func (r *TeamRotationRepository) lock(ctx context.Context, tx *sqlx.Tx, teamID int64) (string, error) {
+	if _, err := tx.ExecContext(ctx, ensureTeamRotationQuery, teamID); err != nil {
+		return "", fmt.Errorf("ensure team rotation: %w", err)
+	}
+
+	var cursor string
+	if err := tx.GetContext(ctx, &cursor, lockTeamRotationQuery, teamID); err != nil {
+		return "", fmt.Errorf("lock team rotation: %w", err)
+	}
+
+	return cursor, nil
+}
+
+func (r *TeamRotationRepository) Save(ctx context.Context, teamID int64, cursor string) error {
+	if _, err := conn(ctx, r.db).ExecContext(ctx, upsertTeamRotationQuery, teamID, cursor); err != nil {
+		return fmt.Errorf("save team rotation: %w", err)
+	}
+
+	return nil
+}
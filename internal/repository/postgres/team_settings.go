@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	domainerrors "pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+const (
+	selectTeamSettingsQuery = `
+		SELECT team_id, reviewers_count, min_approvals, strategy, fallback, require_senior, prefer_working_hours,
+			block_on_changes_requested, require_mandatory_approval, min_reviewers, max_reviewers
+		FROM pr_review.team_settings
+		WHERE team_id = $1`
+
+	upsertTeamSettingsQuery = `
+		INSERT INTO pr_review.team_settings (
+			team_id, reviewers_count, min_approvals, strategy, fallback, require_senior, prefer_working_hours,
+			block_on_changes_requested, require_mandatory_approval, min_reviewers, max_reviewers
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (team_id) DO UPDATE SET
+			reviewers_count = EXCLUDED.reviewers_count,
+			min_approvals = EXCLUDED.min_approvals,
+			strategy = EXCLUDED.strategy,
+			fallback = EXCLUDED.fallback,
+			require_senior = EXCLUDED.require_senior,
+			prefer_working_hours = EXCLUDED.prefer_working_hours,
+			block_on_changes_requested = EXCLUDED.block_on_changes_requested,
+			require_mandatory_approval = EXCLUDED.require_mandatory_approval,
+			min_reviewers = EXCLUDED.min_reviewers,
+			max_reviewers = EXCLUDED.max_reviewers,
+			updated_at = CURRENT_TIMESTAMP`
+
+	selectTeamFallbacksQuery = `
+		SELECT t.id, t.name
+		FROM pr_review.team_fallback f
+		JOIN pr_review.team t ON t.id = f.fallback_team_id
+		WHERE f.team_id = $1
+		ORDER BY f.position`
+
+	deleteTeamFallbacksQuery = `
+		DELETE FROM pr_review.team_fallback
+		WHERE team_id = $1`
+
+	insertTeamFallbackQuery = `
+		INSERT INTO pr_review.team_fallback (team_id, fallback_team_id, position)
+		VALUES ($1, $2, $3)`
+
+	selectTeamMandatoryReviewersQuery = `
+		SELECT user_id
+		FROM pr_review.team_mandatory_reviewer
+		WHERE team_id = $1
+		ORDER BY position`
+
+	deleteTeamMandatoryReviewersQuery = `
+		DELETE FROM pr_review.team_mandatory_reviewer
+		WHERE team_id = $1`
+
+	insertTeamMandatoryReviewerQuery = `
+		INSERT INTO pr_review.team_mandatory_reviewer (team_id, user_id, position)
+		VALUES ($1, $2, $3)`
+
+	selectTeamSizeRulesQuery = `
+		SELECT max_lines, max_files, reviewers_count
+		FROM pr_review.team_size_rule
+		WHERE team_id = $1
+		ORDER BY position`
+
+	deleteTeamSizeRulesQuery = `
+		DELETE FROM pr_review.team_size_rule
+		WHERE team_id = $1`
+
+	insertTeamSizeRuleQuery = `
+		INSERT INTO pr_review.team_size_rule (team_id, position, max_lines, max_files, reviewers_count)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	deleteTeamSettingsQuery = `
+		DELETE FROM pr_review.team_settings
+		WHERE team_id = $1`
+)
+
+type TeamSettingsRepository struct {
+	db *sqlx.DB
+}
+
+func NewTeamSettingsRepository(db *sqlx.DB) *TeamSettingsRepository {
+	return &TeamSettingsRepository{db: db}
+}
+
+func (r *TeamSettingsRepository) GetByTeamID(ctx context.Context, teamID int64) (*models.TeamSettings, error) {
+	var settings models.TeamSettings
+
+	if err := conn(ctx, r.db).GetContext(ctx, &settings, selectTeamSettingsQuery, teamID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domainerrors.NewNotFoundError(fmt.Sprintf("team settings for team %d not found", teamID))
+		}
+		return nil, fmt.Errorf("get team settings: %w", err)
+	}
+
+	if err := conn(ctx, r.db).SelectContext(ctx, &settings.FallbackTeams, selectTeamFallbacksQuery, teamID); err != nil {
+		return nil, fmt.Errorf("select team fallbacks: %w", err)
+	}
+	if settings.FallbackTeams == nil {
+		settings.FallbackTeams = []models.Team{}
+	}
+
+	if err := conn(ctx, r.db).SelectContext(ctx, &settings.MandatoryReviewers, selectTeamMandatoryReviewersQuery, teamID); err != nil {
+		return nil, fmt.Errorf("select team mandatory reviewers: %w", err)
+	}
+	if settings.MandatoryReviewers == nil {
+		settings.MandatoryReviewers = []string{}
+	}
+
+	if err := conn(ctx, r.db).SelectContext(ctx, &settings.SizeRules, selectTeamSizeRulesQuery, teamID); err != nil {
+		return nil, fmt.Errorf("select team size rules: %w", err)
+	}
+	if settings.SizeRules == nil {
+		settings.SizeRules = []models.SizeRule{}
+	}
+
+	return &settings, nil
+}
+
+func (r *TeamSettingsRepository) Upsert(ctx context.Context, settings *models.TeamSettings) error {
+	if settings == nil {
+		return fmt.Errorf("team settings cannot be nil")
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer rollbackTransaction(tx)
+
+	_, err = tx.ExecContext(
+		ctx,
+		upsertTeamSettingsQuery,
+		settings.TeamID,
+		settings.ReviewersCount,
+		settings.MinApprovals,
+		settings.Strategy,
+		settings.Fallback,
+		settings.RequireSenior,
+		settings.PreferWorkingHours,
+		settings.BlockOnChangesRequested,
+		settings.RequireMandatoryApproval,
+		settings.MinReviewers,
+		settings.MaxReviewers,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert team settings: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteTeamFallbacksQuery, settings.TeamID); err != nil {
+		return fmt.Errorf("delete team fallbacks: %w", err)
+	}
+	for i, fallback := range settings.FallbackTeams {
+		if _, err := tx.ExecContext(ctx, insertTeamFallbackQuery, settings.TeamID, fallback.ID, i); err != nil {
+			return fmt.Errorf("insert team fallback: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteTeamMandatoryReviewersQuery, settings.TeamID); err != nil {
+		return fmt.Errorf("delete team mandatory reviewers: %w", err)
+	}
+	for i, userID := range settings.MandatoryReviewers {
+		if _, err := tx.ExecContext(ctx, insertTeamMandatoryReviewerQuery, settings.TeamID, userID, i); err != nil {
+			return fmt.Errorf("insert team mandatory reviewer: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteTeamSizeRulesQuery, settings.TeamID); err != nil {
+		return fmt.Errorf("delete team size rules: %w", err)
+	}
+	for i, rule := range settings.SizeRules {
+		if _, err := tx.ExecContext(ctx, insertTeamSizeRuleQuery, settings.TeamID, i, rule.MaxLines, rule.MaxFiles, rule.ReviewersCount); err != nil {
+			return fmt.Errorf("insert team size rule: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TeamSettingsRepository) Delete(ctx context.Context, teamID int64) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer rollbackTransaction(tx)
+
+	if _, err := tx.ExecContext(ctx, deleteTeamFallbacksQuery, teamID); err != nil {
+		return fmt.Errorf("delete team fallbacks: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, deleteTeamMandatoryReviewersQuery, teamID); err != nil {
+		return fmt.Errorf("delete team mandatory reviewers: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, deleteTeamSizeRulesQuery, teamID); err != nil {
+		return fmt.Errorf("delete team size rules: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, deleteTeamSettingsQuery, teamID); err != nil {
+		return fmt.Errorf("delete team settings: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
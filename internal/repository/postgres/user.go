@@ -8,30 +8,32 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"pr-review/internal/models"
 )
 
 const (
 	selectUserByIDQuery = `
-		SELECT id, name, team_id, is_active
+		SELECT id, name, team_id, is_active, seniority, max_open_reviews, expertise, time_zone, work_start, work_end, review_weight
 		FROM pr_review.user
 		WHERE id = $1`
 
 	insertUserQuery = `
-		INSERT INTO pr_review.user (id, name, team_id, is_active)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id`
+		INSERT INTO pr_review.user (id, name, team_id, is_active, seniority)
+		VALUES ($1, $2, $3, $4, COALESCE(NULLIF($5, ''), 'middle'))
+		RETURNING id, seniority`
 
 	upsertUserQuery = `
-		INSERT INTO pr_review.user (id, name, team_id, is_active)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO pr_review.user (id, name, team_id, is_active, seniority)
+		VALUES ($1, $2, $3, $4, COALESCE(NULLIF($5, ''), 'middle'))
 		ON CONFLICT (id) DO UPDATE SET
 			name = EXCLUDED.name,
 			team_id = EXCLUDED.team_id,
 			is_active = EXCLUDED.is_active,
+			seniority = COALESCE(NULLIF($5, ''), pr_review.user.seniority),
 			updated_at = CURRENT_TIMESTAMP
-		RETURNING id`
+		RETURNING id, seniority`
 
 	deactivateUsersByTeamQuery = `
 		UPDATE pr_review.user
@@ -51,7 +53,7 @@ func NewUserRepository(db *sqlx.DB) *UserRepository {
 func (r *UserRepository) GetByID(ctx context.Context, userID string) (*models.User, error) {
 	var user models.User
 
-	if err := r.db.GetContext(ctx, &user, selectUserByIDQuery, userID); err != nil {
+	if err := conn(ctx, r.db).GetContext(ctx, &user, selectUserByIDQuery, userID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("user with id %s not found", userID)
 		}
@@ -75,7 +77,7 @@ func (r *UserRepository) List(ctx context.Context, filter models.ListUserFilter)
 	}
 
 	var users []*models.User
-	if err = r.db.SelectContext(ctx, &users, query, args...); err != nil {
+	if err = conn(ctx, r.db).SelectContext(ctx, &users, query, args...); err != nil {
 		return nil, fmt.Errorf("select users list: %w", err)
 	}
 
@@ -103,6 +105,27 @@ func (r *UserRepository) Update(ctx context.Context, u models.UserUpdate) error
 	if u.IsActive != nil {
 		builder = builder.Set("is_active", *u.IsActive)
 	}
+	if u.Seniority != nil {
+		builder = builder.Set("seniority", *u.Seniority)
+	}
+	if u.MaxOpenReviews != nil {
+		builder = builder.Set("max_open_reviews", *u.MaxOpenReviews)
+	}
+	if u.ClearMaxOpenReviews {
+		builder = builder.Set("max_open_reviews", nil)
+	}
+	if u.Expertise != nil {
+		builder = builder.Set("expertise", pq.StringArray(*u.Expertise))
+	}
+	if u.ReviewWeight != nil {
+		builder = builder.Set("review_weight", *u.ReviewWeight)
+	}
+	if u.WorkingHours != nil {
+		builder = builder.
+			Set("time_zone", u.WorkingHours.TimeZone).
+			Set("work_start", u.WorkingHours.Start).
+			Set("work_end", u.WorkingHours.End)
+	}
 
 	builder = builder.Where(squirrel.Eq{"id": u.ID})
 
@@ -111,7 +134,7 @@ func (r *UserRepository) Update(ctx context.Context, u models.UserUpdate) error
 		return fmt.Errorf("build update user query: %w", err)
 	}
 
-	res, err := r.db.ExecContext(ctx, query, args...)
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("exec update user: %w", err)
 	}
@@ -136,7 +159,7 @@ func (r *UserRepository) Create(ctx context.Context, user *models.User) error {
 		return fmt.Errorf("user id is required")
 	}
 
-	if err := r.db.QueryRowxContext(ctx, insertUserQuery, user.ID, user.Name, user.TeamID, user.IsActive).Scan(&user.ID); err != nil {
+	if err := conn(ctx, r.db).QueryRowxContext(ctx, insertUserQuery, user.ID, user.Name, user.TeamID, user.IsActive, user.Seniority).Scan(&user.ID, &user.Seniority); err != nil {
 		return fmt.Errorf("insert user: %w", err)
 	}
 
@@ -152,7 +175,7 @@ func (r *UserRepository) Upsert(ctx context.Context, user *models.User) error {
 		return r.Create(ctx, user)
 	}
 
-	if err := r.db.QueryRowxContext(ctx, upsertUserQuery, user.ID, user.Name, user.TeamID, user.IsActive).Scan(&user.ID); err != nil {
+	if err := conn(ctx, r.db).QueryRowxContext(ctx, upsertUserQuery, user.ID, user.Name, user.TeamID, user.IsActive, user.Seniority).Scan(&user.ID, &user.Seniority); err != nil {
 		return fmt.Errorf("upsert user: %w", err)
 	}
 
@@ -160,7 +183,7 @@ func (r *UserRepository) Upsert(ctx context.Context, user *models.User) error {
 }
 
 func (r *UserRepository) DeactivateByTeamID(ctx context.Context, teamID int64) ([]string, error) {
-	rows, err := r.db.QueryxContext(ctx, deactivateUsersByTeamQuery, teamID)
+	rows, err := conn(ctx, r.db).QueryxContext(ctx, deactivateUsersByTeamQuery, teamID)
 	if err != nil {
 		return nil, fmt.Errorf("deactivate users by team: %w", err)
 	}
@@ -10,7 +10,7 @@ type userSelectBuilder struct {
 
 func newUserSelectBuilder() *userSelectBuilder {
 	b := newQueryBuilder().
-		Select("id", "name", "team_id", "is_active").
+		Select("id", "name", "team_id", "is_active", "seniority", "max_open_reviews", "expertise", "time_zone", "work_start", "work_end", "review_weight").
 		From("pr_review.user")
 
 	return &userSelectBuilder{b: b}
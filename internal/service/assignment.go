@@ -0,0 +1,477 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+type assignment struct {
+	reviewers    []string
+	overCapacity []string
+}
+
+type assignmentState struct {
+	exclude   map[string]bool
+	conflicts map[string]bool
+	picked    map[string]models.AssignmentReason
+	teams     map[string]int64
+	cursors   map[int64]string
+}
+
+func newAssignmentState(exclude ...string) *assignmentState {
+	state := &assignmentState{
+		exclude:   make(map[string]bool, len(exclude)),
+		conflicts: make(map[string]bool),
+		picked:    make(map[string]models.AssignmentReason),
+		teams:     make(map[string]int64),
+		cursors:   make(map[int64]string),
+	}
+	for _, id := range exclude {
+		state.exclude[id] = true
+	}
+	return state
+}
+
+func (st *assignmentState) pick(userID string, teamID int64, reason models.AssignmentReason) {
+	st.exclude[userID] = true
+	st.picked[userID] = reason
+	st.teams[userID] = teamID
+}
+
+func (s *Service) saveRotation(ctx context.Context, state *assignmentState) error {
+	for teamID, cursor := range state.cursors {
+		if err := s.rotationRepo.Save(ctx, teamID, cursor); err != nil {
+			return fmt.Errorf("save team rotation: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) teamCandidates(ctx context.Context, teamID int64, exclude map[string]bool) ([]*models.User, []string, error) {
+	members, err := s.userRepo.List(ctx, models.ListUserFilter{
+		TeamID:   &teamID,
+		IsActive: func() *bool { b := true; return &b }(),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get team members: %w", err)
+	}
+
+	return s.eligibleCandidates(ctx, members, exclude)
+}
+
+func (s *Service) eligibleCandidates(ctx context.Context, users []*models.User, exclude map[string]bool) ([]*models.User, []string, error) {
+	if err := s.fillAvailability(ctx, users); err != nil {
+		return nil, nil, err
+	}
+
+	available := make([]*models.User, 0, len(users))
+	for _, u := range users {
+		if u.IsAvailable() && !exclude[u.ID] {
+			available = append(available, u)
+		}
+	}
+
+	return s.filterByCapacity(ctx, available)
+}
+
+func (s *Service) filterByCapacity(ctx context.Context, users []*models.User) ([]*models.User, []string, error) {
+	limited := make([]string, 0)
+	for _, u := range users {
+		if _, ok := u.ReviewLimit(s.maxOpenReviews); ok {
+			limited = append(limited, u.ID)
+		}
+	}
+	if len(limited) == 0 {
+		return users, []string{}, nil
+	}
+
+	stats, err := s.pullRequestRepo.CountOpenReviewsByUsers(ctx, limited)
+	if err != nil {
+		return nil, nil, fmt.Errorf("count open reviews: %w", err)
+	}
+
+	load := make(map[string]int64, len(stats))
+	for _, st := range stats {
+		load[st.UserID] = st.Assignments
+	}
+
+	candidates := make([]*models.User, 0, len(users))
+	overCapacity := make([]string, 0)
+	for _, u := range users {
+		if limit, ok := u.ReviewLimit(s.maxOpenReviews); ok && load[u.ID] >= int64(limit) {
+			overCapacity = append(overCapacity, u.ID)
+			continue
+		}
+		candidates = append(candidates, u)
+	}
+
+	return candidates, overCapacity, nil
+}
+
+func (s *Service) mandatoryReviewers(ctx context.Context, settings *models.TeamSettings, state *assignmentState) ([]string, error) {
+	out := make([]string, 0, len(settings.MandatoryReviewers))
+	if len(settings.MandatoryReviewers) == 0 {
+		return out, nil
+	}
+
+	users, err := s.userRepo.List(ctx, models.ListUserFilter{
+		IDs:      settings.MandatoryReviewers,
+		IsActive: func() *bool { b := true; return &b }(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get mandatory reviewers: %w", err)
+	}
+
+	active := make(map[string]*models.User, len(users))
+	for _, u := range users {
+		if u.IsActive {
+			active[u.ID] = u
+		}
+	}
+
+	for _, id := range settings.MandatoryReviewers {
+		if u, ok := active[id]; ok && !state.exclude[id] {
+			state.pick(id, u.TeamID, models.ReasonMandatory)
+			out = append(out, id)
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Service) ownerReviewers(
+	ctx context.Context,
+	settings *models.TeamSettings,
+	author *models.User,
+	pr *models.PullRequest,
+	changedFiles []string,
+	state *assignmentState,
+	count int,
+) ([]string, error) {
+	out := make([]string, 0, count)
+	if count <= 0 || len(changedFiles) == 0 {
+		return out, nil
+	}
+
+	ownerIDs, err := s.pathOwners(ctx, settings.TeamID, changedFiles)
+	if err != nil {
+		return nil, err
+	}
+	if len(ownerIDs) == 0 {
+		return out, nil
+	}
+
+	owners, err := s.userRepo.List(ctx, models.ListUserFilter{
+		IDs:      ownerIDs,
+		IsActive: func() *bool { b := true; return &b }(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get path owners: %w", err)
+	}
+
+	candidates, _, err := s.eligibleCandidates(ctx, owners, state.exclude)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return out, nil
+	}
+
+	picked, err := s.selectReviewers(ctx, settings, SelectionRequest{
+		TeamID:      settings.TeamID,
+		Author:      author,
+		PullRequest: pr,
+		Candidates:  candidates,
+		Count:       count,
+		Cursors:     state.cursors,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ownerTeam := make(map[string]int64, len(candidates))
+	for _, u := range candidates {
+		ownerTeam[u.ID] = u.TeamID
+	}
+
+	for _, id := range picked {
+		state.pick(id, ownerTeam[id], models.ReasonCodeOwner)
+		out = append(out, id)
+	}
+
+	return out, nil
+}
+
+func (s *Service) seniorReviewer(
+	ctx context.Context,
+	settings *models.TeamSettings,
+	author *models.User,
+	pr *models.PullRequest,
+	current []string,
+	state *assignmentState,
+) ([]string, error) {
+	if !settings.RequireSenior || settings.ReviewersCountFor(pr.PullRequestSize) == 0 {
+		return []string{}, nil
+	}
+
+	hasSenior, err := s.hasSenior(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+	if hasSenior {
+		return []string{}, nil
+	}
+
+	assigned, err := s.assignReviewersWhere(ctx, settings, author, pr, state, 1, (*models.User).IsSenior, models.ReasonSeniorRequired)
+	if err != nil {
+		return nil, err
+	}
+	if len(assigned.reviewers) == 0 {
+		return nil, errors.NewBusinessLogicError("senior reviewer required but no senior candidate available")
+	}
+
+	return assigned.reviewers, nil
+}
+
+func (s *Service) seniorFilter(ctx context.Context, settings *models.TeamSettings, reviewers []string) (func(*models.User) bool, error) {
+	if !settings.RequireSenior {
+		return nil, nil
+	}
+
+	hasSenior, err := s.hasSenior(ctx, reviewers)
+	if err != nil {
+		return nil, err
+	}
+	if hasSenior {
+		return nil, nil
+	}
+
+	return (*models.User).IsSenior, nil
+}
+
+func (s *Service) hasSenior(ctx context.Context, userIDs []string) (bool, error) {
+	if len(userIDs) == 0 {
+		return false, nil
+	}
+
+	users, err := s.userRepo.List(ctx, models.ListUserFilter{IDs: userIDs})
+	if err != nil {
+		return false, fmt.Errorf("get reviewers: %w", err)
+	}
+
+	for _, u := range users {
+		if u.IsActive && u.IsSenior() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *Service) assignReviewers(
+	ctx context.Context,
+	settings *models.TeamSettings,
+	author *models.User,
+	pr *models.PullRequest,
+	state *assignmentState,
+	count int,
+) (*assignment, error) {
+	return s.assignReviewersWhere(ctx, settings, author, pr, state, count, nil, models.ReasonStrategy)
+}
+
+func (s *Service) assignReviewersWhere(
+	ctx context.Context,
+	settings *models.TeamSettings,
+	author *models.User,
+	pr *models.PullRequest,
+	state *assignmentState,
+	count int,
+	keep func(*models.User) bool,
+	reason models.AssignmentReason,
+) (*assignment, error) {
+	pools := make([]int64, 0, len(settings.FallbackTeams)+1)
+	pools = append(pools, settings.TeamID)
+	for _, t := range settings.FallbackTeams {
+		pools = append(pools, t.ID)
+	}
+
+	result := &assignment{
+		reviewers:    make([]string, 0, count),
+		overCapacity: make([]string, 0),
+	}
+	for _, teamID := range pools {
+		missing := count - len(result.reviewers)
+		if missing <= 0 {
+			break
+		}
+
+		candidates, overCapacity, err := s.teamCandidates(ctx, teamID, state.exclude)
+		if err != nil {
+			return nil, err
+		}
+		result.overCapacity = append(result.overCapacity, overCapacity...)
+		if keep != nil {
+			candidates = filterUsers(candidates, keep)
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		picked, err := s.selectReviewers(ctx, settings, SelectionRequest{
+			TeamID:      teamID,
+			Author:      author,
+			PullRequest: pr,
+			Candidates:  candidates,
+			Count:       missing,
+			Cursors:     state.cursors,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range picked {
+			state.pick(id, teamID, reason)
+			result.reviewers = append(result.reviewers, id)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) explicitReviewer(
+	ctx context.Context,
+	settings *models.TeamSettings,
+	pr *models.PullRequest,
+	userID string,
+	allowCrossTeam bool,
+	state *assignmentState,
+	keep func(*models.User) bool,
+	reason models.AssignmentReason,
+) (string, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return "", errors.NewNotFoundError("new reviewer not found")
+	}
+
+	switch {
+	case !user.IsActive:
+		return "", errors.NewBusinessLogicError("new reviewer is not active")
+	case user.ID == pr.AuthorID:
+		return "", errors.NewBusinessLogicError("new reviewer is the PR author")
+	case state.conflicts[user.ID]:
+		return "", errors.NewBusinessLogicError("new reviewer is excluded from reviewing this author")
+	case state.exclude[user.ID]:
+		return "", errors.NewBusinessLogicError("new reviewer is already assigned to this PR")
+	case keep != nil && !keep(user):
+		return "", errors.NewBusinessLogicError("senior reviewer required but new reviewer is not senior")
+	}
+
+	if !allowCrossTeam && !inReviewerPool(settings, user.TeamID) {
+		return "", errors.NewBusinessLogicError("new reviewer is not a member of the PR's reviewer teams")
+	}
+
+	if err := s.checkEligible(ctx, user); err != nil {
+		return "", err
+	}
+
+	state.pick(user.ID, user.TeamID, reason)
+
+	return user.ID, nil
+}
+
+func (s *Service) checkEligible(ctx context.Context, user *models.User) error {
+	if err := s.fillAvailability(ctx, []*models.User{user}); err != nil {
+		return err
+	}
+	if !user.IsAvailable() {
+		return errors.NewBusinessLogicError("new reviewer is out of office")
+	}
+
+	_, overCapacity, err := s.filterByCapacity(ctx, []*models.User{user})
+	if err != nil {
+		return err
+	}
+	if len(overCapacity) > 0 {
+		return errors.NewBusinessLogicError("new reviewer has reached the open review limit")
+	}
+
+	return nil
+}
+
+func inReviewerPool(settings *models.TeamSettings, teamID int64) bool {
+	if settings.TeamID == teamID {
+		return true
+	}
+	for _, t := range settings.FallbackTeams {
+		if t.ID == teamID {
+			return true
+		}
+	}
+	return false
+}
+
+func filterUsers(users []*models.User, keep func(*models.User) bool) []*models.User {
+	out := make([]*models.User, 0, len(users))
+	for _, u := range users {
+		if keep(u) {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+func (s *Service) fillReviewerSources(ctx context.Context, pr *models.PullRequest) error {
+	pr.ReviewerSources = []models.ReviewerSource{}
+	if len(pr.Reviewers) == 0 {
+		return nil
+	}
+
+	users, err := s.userRepo.List(ctx, models.ListUserFilter{IDs: pr.Reviewers})
+	if err != nil {
+		return fmt.Errorf("get reviewers: %w", err)
+	}
+
+	sourceTeams := map[string]int64{}
+	if pr.ID != 0 {
+		sourceTeams, err = s.historyRepo.SourceTeams(ctx, pr.ID)
+		if err != nil {
+			return fmt.Errorf("get reviewer source teams: %w", err)
+		}
+	}
+
+	teamIDs := make([]int64, 0, len(users))
+	userTeam := make(map[string]int64, len(users))
+	userScore := make(map[string]int, len(users))
+	for _, u := range users {
+		teamID, ok := sourceTeams[u.ID]
+		if !ok {
+			teamID = u.TeamID
+		}
+		userTeam[u.ID] = teamID
+		userScore[u.ID] = u.MatchScore(pr.Labels)
+		teamIDs = append(teamIDs, teamID)
+	}
+
+	teams, err := s.teamRepo.List(ctx, models.ListTeamFilter{IDs: teamIDs})
+	if err != nil {
+		return fmt.Errorf("get reviewer teams: %w", err)
+	}
+
+	teamNames := make(map[int64]string, len(teams))
+	for _, t := range teams {
+		teamNames[t.ID] = t.Name
+	}
+
+	for _, reviewerID := range pr.Reviewers {
+		pr.ReviewerSources = append(pr.ReviewerSources, models.ReviewerSource{
+			UserID:     reviewerID,
+			TeamName:   teamNames[userTeam[reviewerID]],
+			MatchScore: userScore[reviewerID],
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+type codeOwnersRule struct {
+	pattern *regexp.Regexp
+	owners  []string
+}
+
+func parseCodeOwners(content string) ([]codeOwnersRule, error) {
+	rules := make([]codeOwnersRule, 0)
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern, err := codeOwnersPatternToRegexp(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+
+		owners := make([]string, 0, len(fields)-1)
+		for _, owner := range fields[1:] {
+			owners = append(owners, strings.TrimPrefix(owner, "@"))
+		}
+
+		rules = append(rules, codeOwnersRule{pattern: pattern, owners: owners})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func codeOwnersPatternToRegexp(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	trimmed := strings.Trim(pattern, "/")
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid pattern %q", pattern)
+	}
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(trimmed, "/")
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+
+	for i := 0; i < len(trimmed); i++ {
+		switch {
+		case strings.HasPrefix(trimmed[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(trimmed[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case trimmed[i] == '*':
+			b.WriteString("[^/]*")
+		case trimmed[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(trimmed[i])))
+		}
+	}
+
+	if dirOnly {
+		b.WriteString("/.*")
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
+
+func matchCodeOwners(rules []codeOwnersRule, files []string) []string {
+	owners := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, file := range files {
+		path := strings.TrimPrefix(file, "/")
+
+		var matched *codeOwnersRule
+		for i := range rules {
+			if rules[i].pattern.MatchString(path) {
+				matched = &rules[i]
+			}
+		}
+		if matched == nil {
+			continue
+		}
+
+		for _, owner := range matched.owners {
+			if !seen[owner] {
+				seen[owner] = true
+				owners = append(owners, owner)
+			}
+		}
+	}
+
+	return owners
+}
+
+func (s *Service) UploadCodeOwners(ctx context.Context, teamName, content string) (*models.Team, *models.CodeOwners, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return nil, nil, errors.NewNotFoundError("team not found")
+	}
+
+	if _, err := parseCodeOwners(content); err != nil {
+		return nil, nil, errors.NewValidationError(fmt.Sprintf("invalid codeowners: %v", err))
+	}
+
+	codeOwners := &models.CodeOwners{
+		TeamID:  team.ID,
+		Content: content,
+	}
+	if err := s.codeOwnersRepo.Create(ctx, codeOwners); err != nil {
+		return nil, nil, fmt.Errorf("save codeowners: %w", err)
+	}
+
+	return team, codeOwners, nil
+}
+
+func (s *Service) GetCodeOwners(ctx context.Context, teamName string, version int) (*models.Team, *models.CodeOwners, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return nil, nil, errors.NewNotFoundError("team not found")
+	}
+
+	var codeOwners *models.CodeOwners
+	if version > 0 {
+		codeOwners, err = s.codeOwnersRepo.GetByVersion(ctx, team.ID, version)
+	} else {
+		codeOwners, err = s.codeOwnersRepo.GetLatest(ctx, team.ID)
+	}
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil, errors.NewNotFoundError("codeowners not found")
+		}
+		return nil, nil, fmt.Errorf("get codeowners: %w", err)
+	}
+
+	return team, codeOwners, nil
+}
+
+func (s *Service) pathOwners(ctx context.Context, teamID int64, files []string) ([]string, error) {
+	if len(files) == 0 {
+		return []string{}, nil
+	}
+
+	codeOwners, err := s.codeOwnersRepo.GetLatest(ctx, teamID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return []string{}, nil
+		}
+		return nil, fmt.Errorf("get latest codeowners: %w", err)
+	}
+
+	rules, err := parseCodeOwners(codeOwners.Content)
+	if err != nil {
+		return nil, fmt.Errorf("parse codeowners version %d: %w", codeOwners.Version, err)
+	}
+
+	return matchCodeOwners(rules, files), nil
+}
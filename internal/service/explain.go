@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"pr-review/internal/models"
+)
+
+func (s *Service) explainCandidates(
+	ctx context.Context,
+	settings *models.TeamSettings,
+	author *models.User,
+	state *assignmentState,
+) ([]models.CandidateExplanation, error) {
+	teamIDs := make([]int64, 0, len(settings.FallbackTeams)+1)
+	teamIDs = append(teamIDs, settings.TeamID)
+	for _, t := range settings.FallbackTeams {
+		teamIDs = append(teamIDs, t.ID)
+	}
+
+	users := make([]*models.User, 0)
+	seen := make(map[string]bool)
+	for _, teamID := range teamIDs {
+		members, err := s.userRepo.List(ctx, models.ListUserFilter{TeamID: &teamID})
+		if err != nil {
+			return nil, fmt.Errorf("get team members: %w", err)
+		}
+		for _, m := range members {
+			if !seen[m.ID] {
+				seen[m.ID] = true
+				users = append(users, m)
+			}
+		}
+	}
+
+	extra := make([]string, 0)
+	for id := range state.picked {
+		if !seen[id] {
+			extra = append(extra, id)
+		}
+	}
+	if len(extra) > 0 {
+		others, err := s.userRepo.List(ctx, models.ListUserFilter{IDs: extra})
+		if err != nil {
+			return nil, fmt.Errorf("get reviewers: %w", err)
+		}
+		users = append(users, others...)
+	}
+
+	if err := s.fillAvailability(ctx, users); err != nil {
+		return nil, err
+	}
+
+	_, overCapacity, err := s.filterByCapacity(ctx, users)
+	if err != nil {
+		return nil, err
+	}
+	over := make(map[string]bool, len(overCapacity))
+	for _, id := range overCapacity {
+		over[id] = true
+	}
+
+	userTeamIDs := make([]int64, 0, len(users))
+	for _, u := range users {
+		userTeamIDs = append(userTeamIDs, u.TeamID)
+	}
+	teams, err := s.teamRepo.List(ctx, models.ListTeamFilter{IDs: userTeamIDs})
+	if err != nil {
+		return nil, fmt.Errorf("get candidate teams: %w", err)
+	}
+	teamNames := make(map[int64]string, len(teams))
+	for _, t := range teams {
+		teamNames[t.ID] = t.Name
+	}
+
+	out := make([]models.CandidateExplanation, 0, len(users))
+	for _, u := range users {
+		explanation := models.CandidateExplanation{
+			UserID:   u.ID,
+			TeamName: teamNames[u.TeamID],
+		}
+
+		if reason, ok := state.picked[u.ID]; ok {
+			explanation.Selected = true
+			explanation.Reason = reason
+		} else {
+			switch {
+			case u.ID == author.ID:
+				explanation.Reason = models.ReasonAuthor
+			case !u.IsActive:
+				explanation.Reason = models.ReasonInactive
+			case u.OutOfOfficeUntil != nil:
+				explanation.Reason = models.ReasonOutOfOffice
+			case state.conflicts[u.ID]:
+				explanation.Reason = models.ReasonExcluded
+			case state.exclude[u.ID]:
+				explanation.Reason = models.ReasonAlreadyAssigned
+			case over[u.ID]:
+				explanation.Reason = models.ReasonOverCapacity
+			default:
+				explanation.Reason = models.ReasonNotSelected
+			}
+		}
+
+		out = append(out, explanation)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].UserID < out[j].UserID
+	})
+
+	return out, nil
+}
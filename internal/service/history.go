@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+func assignmentEvents(pr *models.PullRequest, reviewerIDs []string, state *assignmentState) []models.AssignmentEvent {
+	events := make([]models.AssignmentEvent, 0, len(reviewerIDs))
+	for _, id := range reviewerIDs {
+		event := models.AssignmentEvent{
+			PullRequestID: pr.ID,
+			AuthorID:      pr.AuthorID,
+			ReviewerID:    id,
+			Action:        models.AssignmentAssigned,
+			Reason:        state.picked[id],
+		}
+		if teamID, ok := state.teams[id]; ok {
+			event.TeamID = &teamID
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func unassignmentEvents(pr *models.PullRequest, reviewerIDs []string, reason models.AssignmentReason) []models.AssignmentEvent {
+	events := make([]models.AssignmentEvent, 0, len(reviewerIDs))
+	for _, id := range reviewerIDs {
+		events = append(events, models.AssignmentEvent{
+			PullRequestID: pr.ID,
+			AuthorID:      pr.AuthorID,
+			ReviewerID:    id,
+			Action:        models.AssignmentUnassigned,
+			Reason:        reason,
+		})
+	}
+	return events
+}
+
+func (s *Service) recordAssignments(ctx context.Context, events ...[]models.AssignmentEvent) error {
+	all := make([]models.AssignmentEvent, 0)
+	for _, e := range events {
+		all = append(all, e...)
+	}
+
+	if err := s.historyRepo.Record(ctx, all); err != nil {
+		return fmt.Errorf("record assignment history: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetAssignmentHistory(ctx context.Context, prID string) (*models.PullRequest, []*models.AssignmentEvent, error) {
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, prID)
+	if err != nil {
+		return nil, nil, errors.NewNotFoundError("pull request not found")
+	}
+
+	events, err := s.historyRepo.ListByPullRequest(ctx, pr.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pr, events, nil
+}
+
+func (s *Service) GetTeamPairings(ctx context.Context, teamName string) (*models.Team, []models.PairingStat, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return nil, nil, errors.NewNotFoundError("team not found")
+	}
+
+	members, err := s.userRepo.List(ctx, models.ListUserFilter{TeamID: &team.ID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get team members: %w", err)
+	}
+
+	authorIDs := make([]string, 0, len(members))
+	for _, m := range members {
+		authorIDs = append(authorIDs, m.ID)
+	}
+
+	pairings, err := s.historyRepo.PairingMatrix(ctx, authorIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return team, pairings, nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+
+	"pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+type pullRequestAction string
+
+const (
+	actionMarkReady pullRequestAction = "mark ready"
+	actionClose     pullRequestAction = "close"
+	actionReopen    pullRequestAction = "reopen"
+	actionMerge     pullRequestAction = "merge"
+)
+
+var pullRequestTransitions = map[pullRequestAction][]models.PullRequestStatus{
+	actionMarkReady: {models.PRStatusDraft},
+	actionClose:     {models.PRStatusDraft, models.PRStatusOpen, models.PRStatusUnderstaffed},
+	actionReopen:    {models.PRStatusClosed},
+	actionMerge:     {models.PRStatusOpen, models.PRStatusUnderstaffed},
+}
+
+func checkTransition(action pullRequestAction, status models.PullRequestStatus) error {
+	for _, from := range pullRequestTransitions[action] {
+		if from == status {
+			return nil
+		}
+	}
+	return errors.NewTransitionError(string(action), string(status))
+}
+
+func (s *Service) MarkPullRequestReady(ctx context.Context, prID string) (*models.PullRequest, error) {
+	return s.activatePullRequest(ctx, prID, actionMarkReady)
+}
+
+func (s *Service) ReopenPullRequest(ctx context.Context, prID string) (*models.PullRequest, error) {
+	return s.activatePullRequest(ctx, prID, actionReopen)
+}
+
+func (s *Service) activatePullRequest(ctx context.Context, prID string, action pullRequestAction) (*models.PullRequest, error) {
+	var pr *models.PullRequest
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = s.replanPullRequest(ctx, prID, action)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (s *Service) replanPullRequest(ctx context.Context, prID string, action pullRequestAction) (*models.PullRequest, error) {
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, prID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("pull request not found")
+	}
+
+	if err := checkTransition(action, pr.Status); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.planPullRequest(ctx, models.PullRequestCreate{
+		PullRequestID: pr.PullRequestID,
+		Title:         pr.Title,
+		AuthorID:      pr.AuthorID,
+		ChangedFiles:  pr.ChangedFiles,
+		Labels:        pr.Labels,
+		Size:          pr.PullRequestSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reviewers := []string(plan.pr.Reviewers)
+	update := models.PullRequestUpdate{
+		ID:        pr.ID,
+		Status:    &plan.pr.Status,
+		Reviewers: &reviewers,
+		Version:   &pr.Version,
+	}
+
+	if err := s.savePullRequest(ctx, update); err != nil {
+		return nil, err
+	}
+
+	if err := s.saveRotation(ctx, plan.state); err != nil {
+		return nil, err
+	}
+
+	pr.Version++
+	pr.Status = plan.pr.Status
+	pr.Reviewers = plan.pr.Reviewers
+
+	if err := s.recordAssignments(ctx, assignmentEvents(pr, pr.Reviewers, plan.state)); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviews(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviewerSources(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (s *Service) ClosePullRequest(ctx context.Context, prID string) (*models.PullRequest, error) {
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, prID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("pull request not found")
+	}
+
+	if err := checkTransition(actionClose, pr.Status); err != nil {
+		return nil, err
+	}
+
+	status := models.PRStatusClosed
+	reviewers := []string{}
+	update := models.PullRequestUpdate{
+		ID:        pr.ID,
+		Status:    &status,
+		Reviewers: &reviewers,
+		Version:   &pr.Version,
+	}
+
+	if err := s.savePullRequest(ctx, update); err != nil {
+		return nil, err
+	}
+
+	freed := pr.Reviewers
+	pr.Version++
+	pr.Status = status
+	pr.Reviewers = reviewers
+
+	if err := s.recordAssignments(ctx, unassignmentEvents(pr, freed, models.ReasonClosed)); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviews(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviewerSources(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
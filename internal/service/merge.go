@@ -0,0 +1,52 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"pr-review/internal/models"
+)
+
+func mergeBlockers(settings *models.TeamSettings, pr *models.PullRequest) []string {
+	verdicts := make(map[string]models.ReviewVerdict, len(pr.Reviews))
+	for _, r := range pr.Reviews {
+		verdicts[r.ReviewerID] = r.Verdict
+	}
+
+	approvals := 0
+	changesRequested := make([]string, 0)
+	for _, reviewerID := range pr.Reviewers {
+		switch verdicts[reviewerID] {
+		case models.VerdictApproved:
+			approvals++
+		case models.VerdictChangesRequested:
+			changesRequested = append(changesRequested, reviewerID)
+		}
+	}
+
+	blockers := make([]string, 0)
+	if approvals < settings.MinApprovals {
+		blockers = append(blockers, fmt.Sprintf("%d of %d required approvals", approvals, settings.MinApprovals))
+	}
+
+	if settings.BlockOnChangesRequested && len(changesRequested) > 0 {
+		sort.Strings(changesRequested)
+		blockers = append(blockers, "changes requested by "+strings.Join(changesRequested, ", "))
+	}
+
+	if settings.RequireMandatoryApproval {
+		missing := make([]string, 0)
+		for _, reviewerID := range pr.Reviewers {
+			if settings.IsMandatoryReviewer(reviewerID) && verdicts[reviewerID] != models.VerdictApproved {
+				missing = append(missing, reviewerID)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			blockers = append(blockers, "mandatory reviewers not approved: "+strings.Join(missing, ", "))
+		}
+	}
+
+	return blockers
+}
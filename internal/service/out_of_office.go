@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+func (s *Service) AddOutOfOffice(ctx context.Context, period *models.OutOfOffice) (*models.OutOfOffice, error) {
+	if _, err := s.userRepo.GetByID(ctx, period.UserID); err != nil {
+		return nil, errors.NewNotFoundError("user not found")
+	}
+
+	if !period.EndsAt.After(period.StartsAt) {
+		return nil, errors.NewValidationError("ends_at must be after starts_at")
+	}
+
+	if err := s.outOfOfficeRepo.Create(ctx, period); err != nil {
+		return nil, fmt.Errorf("create out of office period: %w", err)
+	}
+
+	s.markActivePeriods(period)
+
+	return period, nil
+}
+
+func (s *Service) ListOutOfOffice(ctx context.Context, userID string) ([]*models.OutOfOffice, error) {
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return nil, errors.NewNotFoundError("user not found")
+	}
+
+	periods, err := s.outOfOfficeRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list out of office periods: %w", err)
+	}
+
+	s.markActivePeriods(periods...)
+
+	return periods, nil
+}
+
+func (s *Service) DeleteOutOfOffice(ctx context.Context, id int64) (*models.OutOfOffice, error) {
+	period, err := s.outOfOfficeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewNotFoundError("out of office period not found")
+	}
+
+	if err := s.outOfOfficeRepo.Delete(ctx, id); err != nil {
+		return nil, errors.NewNotFoundError("out of office period not found")
+	}
+
+	s.markActivePeriods(period)
+
+	return period, nil
+}
+
+func (s *Service) markActivePeriods(periods ...*models.OutOfOffice) {
+	now := s.now()
+	for _, p := range periods {
+		p.IsActive = p.ActiveAt(now)
+	}
+}
+
+func (s *Service) fillAvailability(ctx context.Context, users []*models.User) error {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+
+	periods, err := s.outOfOfficeRepo.ListActive(ctx, ids, s.now())
+	if err != nil {
+		return fmt.Errorf("list active out of office periods: %w", err)
+	}
+
+	awayUntil := make(map[string]time.Time, len(periods))
+	for _, p := range periods {
+		if until, ok := awayUntil[p.UserID]; !ok || p.EndsAt.After(until) {
+			awayUntil[p.UserID] = p.EndsAt
+		}
+	}
+
+	for _, u := range users {
+		u.OutOfOfficeUntil = nil
+		if until, ok := awayUntil[u.ID]; ok {
+			u.OutOfOfficeUntil = &until
+		}
+	}
+
+	return nil
+}
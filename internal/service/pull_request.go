@@ -3,64 +3,133 @@ package service
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"strings"
-	"time"
 
 	"pr-review/internal/errors"
 	"pr-review/internal/models"
 )
 
-func (s *Service) CreatePullRequest(ctx context.Context, prID, title, authorIDStr string) (*models.PullRequest, error) {
-	existingPR, err := s.pullRequestRepo.GetByStringID(ctx, prID)
-	if err == nil && existingPR != nil {
-		return nil, errors.NewAlreadyExistsError("PR id already exists")
-	}
+type pullRequestPlan struct {
+	pr       *models.PullRequest
+	author   *models.User
+	settings *models.TeamSettings
+	state    *assignmentState
+}
 
-	author, err := s.userRepo.GetByID(ctx, authorIDStr)
+func (s *Service) planPullRequest(ctx context.Context, in models.PullRequestCreate) (*pullRequestPlan, error) {
+	author, err := s.userRepo.GetByID(ctx, in.AuthorID)
 	if err != nil {
 		return nil, errors.NewNotFoundError("author not found")
 	}
 
-	members, err := s.userRepo.List(ctx, models.ListUserFilter{
-		TeamID:   &author.TeamID,
-		IsActive: func() *bool { b := true; return &b }(),
-	})
+	if !in.Size.IsValid() {
+		return nil, errors.NewValidationError("size metrics must not be negative")
+	}
+
+	pr := &models.PullRequest{
+		PullRequestID: in.PullRequestID,
+		Title:         in.Title,
+		AuthorID:      author.ID,
+		Status:        models.PRStatusOpen,
+		Labels:        normalizeTags(in.Labels),
+		ChangedFiles:  append([]string{}, in.ChangedFiles...),
+
+		PullRequestSize: in.Size,
+	}
+
+	settings, err := s.teamSettings(ctx, author.TeamID)
 	if err != nil {
-		return nil, fmt.Errorf("get team members: %w", err)
+		return nil, err
+	}
+	count := settings.ReviewersCountFor(pr.PullRequestSize)
+	state := newAssignmentState(author.ID)
+	if err := s.excludeConflicts(ctx, author.ID, state); err != nil {
+		return nil, err
 	}
 
-	candidates := make([]string, 0)
-	for _, member := range members {
-		if member.IsActive && member.ID != author.ID {
-			candidates = append(candidates, member.ID)
-		}
+	if in.Draft {
+		pr.Status = models.PRStatusDraft
+		return &pullRequestPlan{
+			pr:       pr,
+			author:   author,
+			settings: settings,
+			state:    state,
+		}, nil
 	}
 
-	reviewers := make([]string, 0, 2)
-	if len(candidates) > 0 {
-		rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-		shuffled := make([]string, len(candidates))
-		copy(shuffled, candidates)
-		rng.Shuffle(len(shuffled), func(i, j int) {
-			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-		})
+	mandatory, err := s.mandatoryReviewers(ctx, settings, state)
+	if err != nil {
+		return nil, err
+	}
 
-		count := 2
-		if len(shuffled) < count {
-			count = len(shuffled)
+	seniors, err := s.seniorReviewer(ctx, settings, author, pr, mandatory, state)
+	if err != nil {
+		return nil, err
+	}
+	required := append(mandatory, seniors...)
+
+	owners, err := s.ownerReviewers(ctx, settings, author, pr, in.ChangedFiles, state, count-len(required))
+	if err != nil {
+		return nil, err
+	}
+
+	assigned, err := s.assignReviewers(ctx, settings, author, pr, state, max(count-len(required)-len(owners), 0))
+	if err != nil {
+		return nil, err
+	}
+	assigned.reviewers = append(append(required, owners...), assigned.reviewers...)
+
+	if len(assigned.reviewers) < count {
+		switch settings.Fallback {
+		case models.FallbackReject:
+			if len(assigned.overCapacity) > 0 {
+				return nil, errors.NewBusinessLogicError("no reviewer capacity left in team")
+			}
+			return nil, errors.NewBusinessLogicError("not enough reviewer candidates in team")
+		case models.FallbackUnderstaffed:
+			pr.Status = models.PRStatusUnderstaffed
 		}
-		reviewers = shuffled[:count]
 	}
+	pr.Reviewers = assigned.reviewers
+
+	return &pullRequestPlan{
+		pr:       pr,
+		author:   author,
+		settings: settings,
+		state:    state,
+	}, nil
+}
 
-	pr := &models.PullRequest{
-		PullRequestID: prID,
-		Title:         title,
-		AuthorID:      author.ID,
-		Status:        models.PRStatusOpen,
-		Reviewers:     reviewers,
+func (s *Service) CreatePullRequest(ctx context.Context, in models.PullRequestCreate) (*models.PullRequest, error) {
+	existingPR, err := s.pullRequestRepo.GetByStringID(ctx, in.PullRequestID)
+	if err == nil && existingPR != nil {
+		return nil, errors.NewAlreadyExistsError("PR id already exists")
+	}
+
+	var pr *models.PullRequest
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = s.createPullRequest(ctx, in)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	if err := s.fillReviewerSources(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (s *Service) createPullRequest(ctx context.Context, in models.PullRequestCreate) (*models.PullRequest, error) {
+	plan, err := s.planPullRequest(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	pr := plan.pr
+
 	if err := s.pullRequestRepo.Create(ctx, pr); err != nil {
 		if strings.Contains(err.Error(), "already exists") || strings.Contains(err.Error(), "duplicate") {
 			return nil, errors.NewAlreadyExistsError("PR id already exists")
@@ -68,11 +137,37 @@ func (s *Service) CreatePullRequest(ctx context.Context, prID, title, authorIDSt
 		return nil, fmt.Errorf("create pull request: %w", err)
 	}
 
+	if err := s.saveRotation(ctx, plan.state); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordAssignments(ctx, assignmentEvents(pr, pr.Reviewers, plan.state)); err != nil {
+		return nil, err
+	}
+
 	return pr, nil
 }
 
-func (s *Service) MergePullRequest(ctx context.Context, prID string) (*models.PullRequest, error) {
-	pr, err := s.pullRequestRepo.GetByStringID(ctx, prID)
+func (s *Service) PreviewReviewers(ctx context.Context, in models.PullRequestCreate) (*models.PullRequest, []models.CandidateExplanation, error) {
+	plan, err := s.planPullRequest(ctx, in)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	candidates, err := s.explainCandidates(ctx, plan.settings, plan.author, plan.state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.fillReviewerSources(ctx, plan.pr); err != nil {
+		return nil, nil, err
+	}
+
+	return plan.pr, candidates, nil
+}
+
+func (s *Service) MergePullRequest(ctx context.Context, in models.PullRequestMerge) (*models.PullRequest, error) {
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, in.PullRequestID)
 	if err != nil {
 		return nil, fmt.Errorf("pull request not found: %w", err)
 	}
@@ -81,25 +176,80 @@ func (s *Service) MergePullRequest(ctx context.Context, prID string) (*models.Pu
 		return pr, nil
 	}
 
-	now := time.Now()
+	if err := checkTransition(actionMerge, pr.Status); err != nil {
+		return nil, err
+	}
+
+	author, err := s.userRepo.GetByID(ctx, pr.AuthorID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("author not found")
+	}
+
+	if err := s.fillReviews(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.teamSettings(ctx, author.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	blockers := mergeBlockers(settings, pr)
+	if len(blockers) > 0 && !in.Force {
+		return nil, errors.NewBusinessLogicError("merge blocked: " + strings.Join(blockers, "; "))
+	}
+
+	now := s.now()
 	update := models.PullRequestUpdate{
 		ID:       pr.ID,
 		Status:   &[]models.PullRequestStatus{models.PRStatusMerged}[0],
 		MergedAt: &now,
+		Version:  &pr.Version,
+	}
+	if len(blockers) > 0 {
+		forced := true
+		update.ForcedMerge = &forced
+		update.MergeBlockers = &blockers
 	}
 
-	if err := s.pullRequestRepo.Update(ctx, update); err != nil {
-		return nil, errors.NewNotFoundError("pull request not found")
+	if err := s.savePullRequest(ctx, update); err != nil {
+		return nil, err
 	}
 
+	pr.Version++
 	pr.Status = models.PRStatusMerged
 	pr.MergedAt = &now
+	if update.ForcedMerge != nil {
+		pr.ForcedMerge = true
+		pr.MergeBlockers = blockers
+	}
+
+	if err := s.fillReviewerSources(ctx, pr); err != nil {
+		return nil, err
+	}
 
 	return pr, nil
 }
 
-func (s *Service) ReassignReviewer(ctx context.Context, prID, oldUserID string) (*models.PullRequest, string, error) {
-	pr, err := s.pullRequestRepo.GetByStringID(ctx, prID)
+func (s *Service) ReassignReviewer(ctx context.Context, in models.PullRequestReassign) (*models.PullRequest, string, error) {
+	var pr *models.PullRequest
+	var newReviewerID string
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		pr, newReviewerID, err = s.reassignReviewer(ctx, in)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pr, newReviewerID, nil
+}
+
+func (s *Service) reassignReviewer(ctx context.Context, in models.PullRequestReassign) (*models.PullRequest, string, error) {
+	oldUserID := in.OldUserID
+
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, in.PullRequestID)
 	if err != nil {
 		return nil, "", fmt.Errorf("pull request not found: %w", err)
 	}
@@ -107,6 +257,9 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldUserID string)
 	if pr.Status == models.PRStatusMerged {
 		return nil, "", errors.NewBusinessLogicError("cannot reassign on merged PR")
 	}
+	if !pr.Status.IsOpen() {
+		return nil, "", errors.NewBusinessLogicError("PR is not open for review")
+	}
 
 	found := false
 	for _, reviewerID := range pr.Reviewers {
@@ -124,32 +277,48 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldUserID string)
 		return nil, "", errors.NewNotFoundError("old reviewer not found")
 	}
 
-	members, err := s.userRepo.List(ctx, models.ListUserFilter{
-		TeamID:   &oldReviewer.TeamID,
-		IsActive: func() *bool { b := true; return &b }(),
-	})
+	author, err := s.userRepo.GetByID(ctx, pr.AuthorID)
 	if err != nil {
-		return nil, "", fmt.Errorf("get team members: %w", err)
+		return nil, "", errors.NewNotFoundError("author not found")
 	}
 
-	candidates := make([]string, 0)
-	reviewerSet := make(map[string]bool)
-	for _, r := range pr.Reviewers {
-		reviewerSet[r] = true
+	authorSettings, err := s.teamSettings(ctx, author.TeamID)
+	if err != nil {
+		return nil, "", err
+	}
+	if oldReviewer.IsActive && authorSettings.IsMandatoryReviewer(oldUserID) {
+		return nil, "", errors.NewBusinessLogicError("cannot reassign mandatory reviewer")
 	}
 
-	for _, member := range members {
-		if member.IsActive && member.ID != oldUserID && !reviewerSet[member.ID] {
-			candidates = append(candidates, member.ID)
+	state := newAssignmentState(append([]string{author.ID, oldUserID}, pr.Reviewers...)...)
+	if err := s.excludeConflicts(ctx, author.ID, state); err != nil {
+		return nil, "", err
+	}
+	remaining := make([]string, 0, len(pr.Reviewers))
+	for _, r := range pr.Reviewers {
+		if r != oldUserID {
+			remaining = append(remaining, r)
 		}
 	}
 
-	if len(candidates) == 0 {
-		return nil, "", errors.NewBusinessLogicError("no active replacement candidate in team")
+	keep, err := s.seniorFilter(ctx, authorSettings, remaining)
+	if err != nil {
+		return nil, "", err
+	}
+	reason := models.ReasonStrategy
+	if keep != nil {
+		reason = models.ReasonSeniorRequired
 	}
 
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-	newReviewerID := candidates[rng.Intn(len(candidates))]
+	poolSettings, err := s.teamSettings(ctx, oldReviewer.TeamID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	newReviewerID, err := s.replacementReviewer(ctx, poolSettings, author, pr, in, state, keep, reason)
+	if err != nil {
+		return nil, "", err
+	}
 
 	newReviewers := make([]string, 0, len(pr.Reviewers))
 	for _, reviewerID := range pr.Reviewers {
@@ -162,17 +331,385 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldUserID string)
 	update := models.PullRequestUpdate{
 		ID:        pr.ID,
 		Reviewers: &newReviewers,
+		Version:   &pr.Version,
 	}
 
-	if err := s.pullRequestRepo.Update(ctx, update); err != nil {
-		return nil, "", errors.NewNotFoundError("pull request not found")
+	if err := s.savePullRequest(ctx, update); err != nil {
+		return nil, "", err
+	}
+
+	if err := s.saveRotation(ctx, state); err != nil {
+		return nil, "", err
 	}
 
+	pr.Version++
 	pr.Reviewers = newReviewers
 
+	if err := s.recordAssignments(
+		ctx,
+		unassignmentEvents(pr, []string{oldUserID}, models.ReasonReplaced),
+		assignmentEvents(pr, []string{newReviewerID}, state),
+	); err != nil {
+		return nil, "", err
+	}
+
+	if err := s.fillReviews(ctx, pr); err != nil {
+		return nil, "", err
+	}
+
+	if err := s.fillReviewerSources(ctx, pr); err != nil {
+		return nil, "", err
+	}
+
 	return pr, newReviewerID, nil
 }
 
+func (s *Service) replacementReviewer(
+	ctx context.Context,
+	settings *models.TeamSettings,
+	author *models.User,
+	pr *models.PullRequest,
+	in models.PullRequestReassign,
+	state *assignmentState,
+	keep func(*models.User) bool,
+	reason models.AssignmentReason,
+) (string, error) {
+	if in.NewUserID != "" {
+		return s.explicitReviewer(ctx, settings, pr, in.NewUserID, in.AllowCrossTeam, state, keep, models.ReasonManual)
+	}
+
+	assigned, err := s.assignReviewersWhere(ctx, settings, author, pr, state, 1, keep, reason)
+	if err != nil {
+		return "", err
+	}
+	if len(assigned.reviewers) == 0 {
+		if keep != nil {
+			return "", errors.NewBusinessLogicError("senior reviewer required but no senior candidate available")
+		}
+		return "", errors.NewBusinessLogicError("no active replacement candidate in team")
+	}
+
+	return assigned.reviewers[0], nil
+}
+
+func (s *Service) ClaimReview(ctx context.Context, in models.PullRequestClaim) (*models.PullRequest, error) {
+	var pr *models.PullRequest
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = s.claimReview(ctx, in)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (s *Service) claimReview(ctx context.Context, in models.PullRequestClaim) (*models.PullRequest, error) {
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, in.PullRequestID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("pull request not found")
+	}
+
+	if pr.Status == models.PRStatusMerged {
+		return nil, errors.NewBusinessLogicError("cannot claim review on merged PR")
+	}
+	if !pr.Status.IsOpen() {
+		return nil, errors.NewBusinessLogicError("PR is not open for review")
+	}
+
+	author, err := s.userRepo.GetByID(ctx, pr.AuthorID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("author not found")
+	}
+
+	authorSettings, err := s.teamSettings(ctx, author.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	count := authorSettings.ReviewersCountFor(pr.PullRequestSize)
+
+	state := newAssignmentState(append([]string{author.ID}, pr.Reviewers...)...)
+	if err := s.excludeConflicts(ctx, author.ID, state); err != nil {
+		return nil, err
+	}
+
+	poolSettings := authorSettings
+	remaining := make([]string, 0, len(pr.Reviewers))
+	if in.ReplaceUserID != "" {
+		found := false
+		for _, r := range pr.Reviewers {
+			if r == in.ReplaceUserID {
+				found = true
+				continue
+			}
+			remaining = append(remaining, r)
+		}
+		if !found {
+			return nil, errors.NewBusinessLogicError("reviewer is not assigned to this PR")
+		}
+
+		replaced, err := s.userRepo.GetByID(ctx, in.ReplaceUserID)
+		if err != nil {
+			return nil, errors.NewNotFoundError("replaced reviewer not found")
+		}
+		if replaced.IsActive && authorSettings.IsMandatoryReviewer(replaced.ID) {
+			return nil, errors.NewBusinessLogicError("cannot reassign mandatory reviewer")
+		}
+		if err := s.fillAvailability(ctx, []*models.User{replaced}); err != nil {
+			return nil, err
+		}
+		if replaced.IsAvailable() {
+			agreed, err := s.swapOfferRepo.Consume(ctx, pr.ID, replaced.ID, in.UserID)
+			if err != nil {
+				return nil, fmt.Errorf("consume swap offer: %w", err)
+			}
+			if !agreed {
+				return nil, errors.NewBusinessLogicError("replaced reviewer has not agreed to the swap")
+			}
+		}
+		poolSettings, err = s.teamSettings(ctx, replaced.TeamID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if len(pr.Reviewers) >= count {
+			return nil, errors.NewBusinessLogicError("no free reviewer slot on this PR")
+		}
+		remaining = append(remaining, pr.Reviewers...)
+	}
+
+	var keep func(*models.User) bool
+	if in.ReplaceUserID != "" || len(remaining)+1 >= count {
+		keep, err = s.seniorFilter(ctx, authorSettings, remaining)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	claimerID, err := s.explicitReviewer(ctx, poolSettings, pr, in.UserID, in.AllowCrossTeam, state, keep, models.ReasonClaimed)
+	if err != nil {
+		return nil, err
+	}
+
+	newReviewers := append(remaining, claimerID)
+	update := models.PullRequestUpdate{
+		ID:        pr.ID,
+		Reviewers: &newReviewers,
+		Version:   &pr.Version,
+	}
+	if pr.Status == models.PRStatusUnderstaffed && len(newReviewers) >= count {
+		update.Status = &[]models.PullRequestStatus{models.PRStatusOpen}[0]
+	}
+
+	if err := s.savePullRequest(ctx, update); err != nil {
+		return nil, err
+	}
+
+	pr.Version++
+	pr.Reviewers = newReviewers
+	if update.Status != nil {
+		pr.Status = *update.Status
+	}
+
+	events := [][]models.AssignmentEvent{assignmentEvents(pr, []string{claimerID}, state)}
+	if in.ReplaceUserID != "" {
+		events = append([][]models.AssignmentEvent{unassignmentEvents(pr, []string{in.ReplaceUserID}, models.ReasonReplaced)}, events...)
+	}
+	if err := s.recordAssignments(ctx, events...); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviews(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviewerSources(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (s *Service) UpdatePullRequest(ctx context.Context, in models.PullRequestEdit) (*models.PullRequest, error) {
+	var pr *models.PullRequest
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = s.updatePullRequest(ctx, in)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (s *Service) updatePullRequest(ctx context.Context, in models.PullRequestEdit) (*models.PullRequest, error) {
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, in.PullRequestID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("pull request not found")
+	}
+
+	if pr.Version != in.Version {
+		return nil, errors.NewBusinessLogicError("pull request version conflict")
+	}
+
+	update := models.PullRequestUpdate{
+		ID:      pr.ID,
+		Version: &in.Version,
+	}
+
+	if in.Title != nil {
+		if *in.Title == "" {
+			return nil, errors.NewValidationError("pull_request_name must not be empty")
+		}
+		update.Title = in.Title
+	}
+
+	if in.NewPullRequestID != nil && *in.NewPullRequestID != pr.PullRequestID {
+		if *in.NewPullRequestID == "" {
+			return nil, errors.NewValidationError("new_pull_request_id must not be empty")
+		}
+		existingPR, err := s.pullRequestRepo.GetByStringID(ctx, *in.NewPullRequestID)
+		if err == nil && existingPR != nil {
+			return nil, errors.NewAlreadyExistsError("PR id already exists")
+		}
+		update.PullRequestID = in.NewPullRequestID
+	}
+
+	var events [][]models.AssignmentEvent
+	state := newAssignmentState()
+	if in.AuthorID != nil && *in.AuthorID != pr.AuthorID {
+		if pr.Status == models.PRStatusMerged {
+			return nil, errors.NewBusinessLogicError("cannot change author of merged PR")
+		}
+
+		author, err := s.userRepo.GetByID(ctx, *in.AuthorID)
+		if err != nil {
+			return nil, errors.NewNotFoundError("author not found")
+		}
+		update.AuthorID = &author.ID
+		pr.AuthorID = author.ID
+
+		state = newAssignmentState(append([]string{author.ID}, pr.Reviewers...)...)
+		reviewers, replaced, err := s.replaceAuthorReviewer(ctx, pr, author, state)
+		if err != nil {
+			return nil, err
+		}
+		if reviewers != nil {
+			update.Reviewers = &reviewers
+			events = replaced
+		}
+	}
+
+	if err := s.pullRequestRepo.Update(ctx, update); err != nil {
+		if strings.Contains(err.Error(), "version conflict") {
+			return nil, errors.NewBusinessLogicError("pull request version conflict")
+		}
+		if strings.Contains(err.Error(), "duplicate") {
+			return nil, errors.NewAlreadyExistsError("PR id already exists")
+		}
+		return nil, fmt.Errorf("update pull request: %w", err)
+	}
+
+	if err := s.saveRotation(ctx, state); err != nil {
+		return nil, err
+	}
+
+	pr.Version++
+	if update.Title != nil {
+		pr.Title = *update.Title
+	}
+	if update.PullRequestID != nil {
+		pr.PullRequestID = *update.PullRequestID
+	}
+	if update.Reviewers != nil {
+		pr.Reviewers = *update.Reviewers
+	}
+
+	if err := s.recordAssignments(ctx, events...); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviews(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviewerSources(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (s *Service) replaceAuthorReviewer(
+	ctx context.Context,
+	pr *models.PullRequest,
+	author *models.User,
+	state *assignmentState,
+) ([]string, [][]models.AssignmentEvent, error) {
+	if err := s.excludeConflicts(ctx, author.ID, state); err != nil {
+		return nil, nil, err
+	}
+
+	remaining := make([]string, 0, len(pr.Reviewers))
+	droppedAuthor := make([]string, 0, 1)
+	droppedConflicts := make([]string, 0)
+	for _, r := range pr.Reviewers {
+		switch {
+		case r == author.ID:
+			droppedAuthor = append(droppedAuthor, r)
+		case state.conflicts[r]:
+			droppedConflicts = append(droppedConflicts, r)
+		default:
+			remaining = append(remaining, r)
+		}
+	}
+	missing := len(droppedAuthor) + len(droppedConflicts)
+	if missing == 0 {
+		return nil, nil, nil
+	}
+
+	settings, err := s.teamSettings(ctx, author.TeamID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keep, err := s.seniorFilter(ctx, settings, remaining)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replacements := make([]string, 0, missing)
+	if keep != nil {
+		assigned, err := s.assignReviewersWhere(ctx, settings, author, pr, state, 1, keep, models.ReasonSeniorRequired)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(assigned.reviewers) == 0 {
+			return nil, nil, errors.NewBusinessLogicError("senior reviewer required but no senior candidate available")
+		}
+		replacements = append(replacements, assigned.reviewers...)
+	}
+
+	assigned, err := s.assignReviewersWhere(ctx, settings, author, pr, state, missing-len(replacements), nil, models.ReasonStrategy)
+	if err != nil {
+		return nil, nil, err
+	}
+	replacements = append(replacements, assigned.reviewers...)
+	if len(replacements) < missing {
+		return nil, nil, errors.NewBusinessLogicError("no active replacement candidate in team")
+	}
+
+	return append(remaining, replacements...), [][]models.AssignmentEvent{
+		unassignmentEvents(pr, droppedAuthor, models.ReasonAuthor),
+		unassignmentEvents(pr, droppedConflicts, models.ReasonExcluded),
+		assignmentEvents(pr, replacements, state),
+	}, nil
+}
+
 func (s *Service) GetPullRequestByStringID(ctx context.Context, prID string) (*models.PullRequest, error) {
 	pr, err := s.pullRequestRepo.GetByStringID(ctx, prID)
 	if err != nil {
@@ -180,3 +717,14 @@ func (s *Service) GetPullRequestByStringID(ctx context.Context, prID string) (*m
 	}
 	return pr, nil
 }
+
+func (s *Service) savePullRequest(ctx context.Context, update models.PullRequestUpdate) error {
+	if err := s.pullRequestRepo.Update(ctx, update); err != nil {
+		if strings.Contains(err.Error(), "version conflict") {
+			return errors.NewBusinessLogicError("pull request version conflict")
+		}
+		return errors.NewNotFoundError("pull request not found")
+	}
+
+	return nil
+}
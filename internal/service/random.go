@@ -0,0 +1,39 @@
+package service
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"sync"
+
+	"pr-review/internal/models"
+)
+
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source
+}
+
+func (l *lockedSource) Int63() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.src.Int63()
+}
+
+func (l *lockedSource) Seed(seed int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.src.Seed(seed)
+}
+
+func pullRequestSeed(pr *models.PullRequest) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(pr.PullRequestID))
+	return int64(h.Sum64())
+}
+
+func (s *Service) rngFor(pr *models.PullRequest) *rand.Rand {
+	if s.deterministic && pr != nil {
+		return rand.New(rand.NewSource(pullRequestSeed(pr)))
+	}
+	return rand.New(s.randSource)
+}
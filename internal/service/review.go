@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+func (s *Service) SubmitReview(ctx context.Context, in models.ReviewCreate) (*models.PullRequest, *models.Review, error) {
+	if !in.Verdict.IsValid() {
+		return nil, nil, errors.NewValidationError(fmt.Sprintf("unknown verdict %q", in.Verdict))
+	}
+
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, in.PullRequestID)
+	if err != nil {
+		return nil, nil, errors.NewNotFoundError("pull request not found")
+	}
+
+	if pr.Status == models.PRStatusMerged {
+		return nil, nil, errors.NewBusinessLogicError("cannot review merged PR")
+	}
+	if !pr.Status.IsOpen() {
+		return nil, nil, errors.NewBusinessLogicError("PR is not open for review")
+	}
+
+	assigned := false
+	for _, reviewerID := range pr.Reviewers {
+		if reviewerID == in.ReviewerID {
+			assigned = true
+			break
+		}
+	}
+	if !assigned {
+		return nil, nil, errors.NewBusinessLogicError("reviewer is not assigned to this PR")
+	}
+
+	review := &models.Review{
+		PullRequestID: pr.ID,
+		ReviewerID:    in.ReviewerID,
+		Verdict:       in.Verdict,
+		Body:          in.Body,
+	}
+	if err := s.reviewRepo.Create(ctx, review); err != nil {
+		return nil, nil, fmt.Errorf("create review: %w", err)
+	}
+
+	if err := s.fillReviews(ctx, pr); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.fillReviewerSources(ctx, pr); err != nil {
+		return nil, nil, err
+	}
+
+	return pr, review, nil
+}
+
+func (s *Service) fillReviews(ctx context.Context, prs ...*models.PullRequest) error {
+	ids := make([]int64, 0, len(prs))
+	for _, pr := range prs {
+		ids = append(ids, pr.ID)
+	}
+
+	reviews, err := s.reviewRepo.ListLatest(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("list latest reviews: %w", err)
+	}
+
+	byPR := make(map[int64][]models.Review, len(prs))
+	for _, r := range reviews {
+		byPR[r.PullRequestID] = append(byPR[r.PullRequestID], *r)
+	}
+
+	for _, pr := range prs {
+		current := make(map[string]bool, len(pr.Reviewers))
+		for _, id := range pr.Reviewers {
+			current[id] = true
+		}
+
+		pr.Reviews = make([]models.Review, 0, len(pr.Reviewers))
+		for _, r := range byPR[pr.ID] {
+			if current[r.ReviewerID] {
+				pr.Reviews = append(pr.Reviews, r)
+			}
+		}
+	}
+
+	return nil
+}
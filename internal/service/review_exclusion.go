@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+func (s *Service) AddReviewExclusion(ctx context.Context, exclusion *models.ReviewExclusion) (*models.ReviewExclusion, error) {
+	if exclusion.ReviewerID == exclusion.AuthorID {
+		return nil, errors.NewValidationError("user cannot be excluded from reviewing themselves")
+	}
+	if _, err := s.userRepo.GetByID(ctx, exclusion.ReviewerID); err != nil {
+		return nil, errors.NewNotFoundError("reviewer not found")
+	}
+	if _, err := s.userRepo.GetByID(ctx, exclusion.AuthorID); err != nil {
+		return nil, errors.NewNotFoundError("author not found")
+	}
+
+	if err := s.exclusionRepo.Create(ctx, exclusion); err != nil {
+		if strings.Contains(err.Error(), "duplicate") {
+			return nil, errors.NewAlreadyExistsError("review exclusion already exists")
+		}
+		return nil, fmt.Errorf("create review exclusion: %w", err)
+	}
+
+	return exclusion, nil
+}
+
+func (s *Service) ListReviewExclusions(ctx context.Context, userID string) ([]*models.ReviewExclusion, error) {
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return nil, errors.NewNotFoundError("user not found")
+	}
+
+	exclusions, err := s.exclusionRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list review exclusions: %w", err)
+	}
+
+	return exclusions, nil
+}
+
+func (s *Service) DeleteReviewExclusion(ctx context.Context, id int64) (*models.ReviewExclusion, error) {
+	exclusion, err := s.exclusionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.NewNotFoundError("review exclusion not found")
+	}
+
+	if err := s.exclusionRepo.Delete(ctx, id); err != nil {
+		return nil, errors.NewNotFoundError("review exclusion not found")
+	}
+
+	return exclusion, nil
+}
+
+func (s *Service) excludeConflicts(ctx context.Context, authorID string, state *assignmentState) error {
+	ids, err := s.exclusionRepo.ExcludedReviewers(ctx, authorID)
+	if err != nil {
+		return fmt.Errorf("get excluded reviewers: %w", err)
+	}
+
+	for _, id := range ids {
+		state.exclude[id] = true
+		state.conflicts[id] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+func (s *Service) OfferReviewSwap(ctx context.Context, in models.ReviewSwapOfferCreate) (*models.PullRequest, *models.ReviewSwapOffer, error) {
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, in.PullRequestID)
+	if err != nil {
+		return nil, nil, errors.NewNotFoundError("pull request not found")
+	}
+
+	if pr.Status == models.PRStatusMerged {
+		return nil, nil, errors.NewBusinessLogicError("cannot claim review on merged PR")
+	}
+	if !pr.Status.IsOpen() {
+		return nil, nil, errors.NewBusinessLogicError("PR is not open for review")
+	}
+
+	assigned := false
+	for _, reviewerID := range pr.Reviewers {
+		if reviewerID == in.ReviewerID {
+			assigned = true
+			break
+		}
+	}
+	if !assigned {
+		return nil, nil, errors.NewBusinessLogicError("reviewer is not assigned to this PR")
+	}
+
+	if in.ClaimerID == in.ReviewerID {
+		return nil, nil, errors.NewValidationError("claimer must differ from the reviewer")
+	}
+	if _, err := s.userRepo.GetByID(ctx, in.ClaimerID); err != nil {
+		return nil, nil, errors.NewNotFoundError("claimer not found")
+	}
+
+	offer := &models.ReviewSwapOffer{
+		PullRequestID: pr.ID,
+		ReviewerID:    in.ReviewerID,
+		ClaimerID:     in.ClaimerID,
+	}
+	if err := s.swapOfferRepo.Create(ctx, offer); err != nil {
+		return nil, nil, fmt.Errorf("create review swap offer: %w", err)
+	}
+
+	return pr, offer, nil
+}
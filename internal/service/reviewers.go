@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+func (s *Service) AddReviewer(ctx context.Context, in models.PullRequestReviewerChange) (*models.PullRequest, error) {
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, in.PullRequestID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("pull request not found")
+	}
+
+	if !pr.Status.IsOpen() {
+		return nil, errors.NewBusinessLogicError("PR is not open for review")
+	}
+
+	author, err := s.userRepo.GetByID(ctx, pr.AuthorID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("author not found")
+	}
+
+	settings, err := s.teamSettings(ctx, author.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if settings.MaxReviewers > 0 && len(pr.Reviewers) >= settings.MaxReviewers {
+		return nil, errors.NewBusinessLogicError(fmt.Sprintf("maximum of %d reviewers reached", settings.MaxReviewers))
+	}
+
+	state := newAssignmentState(append([]string{author.ID}, pr.Reviewers...)...)
+	if err := s.excludeConflicts(ctx, author.ID, state); err != nil {
+		return nil, err
+	}
+
+	reviewerID, err := s.explicitReviewer(ctx, settings, pr, in.UserID, in.AllowCrossTeam, state, nil, models.ReasonManual)
+	if err != nil {
+		return nil, err
+	}
+
+	newReviewers := append(append([]string{}, pr.Reviewers...), reviewerID)
+	update := models.PullRequestUpdate{
+		ID:        pr.ID,
+		Reviewers: &newReviewers,
+		Version:   &pr.Version,
+	}
+	if pr.Status == models.PRStatusUnderstaffed && len(newReviewers) >= settings.ReviewersCountFor(pr.PullRequestSize) {
+		missingSenior, err := s.seniorFilter(ctx, settings, newReviewers)
+		if err != nil {
+			return nil, err
+		}
+		if missingSenior == nil {
+			update.Status = &[]models.PullRequestStatus{models.PRStatusOpen}[0]
+		}
+	}
+
+	if err := s.savePullRequest(ctx, update); err != nil {
+		return nil, err
+	}
+
+	pr.Version++
+	pr.Reviewers = newReviewers
+	if update.Status != nil {
+		pr.Status = *update.Status
+	}
+
+	if err := s.recordAssignments(ctx, assignmentEvents(pr, []string{reviewerID}, state)); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviews(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviewerSources(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (s *Service) RemoveReviewer(ctx context.Context, in models.PullRequestReviewerChange) (*models.PullRequest, error) {
+	pr, err := s.pullRequestRepo.GetByStringID(ctx, in.PullRequestID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("pull request not found")
+	}
+
+	if !pr.Status.IsOpen() {
+		return nil, errors.NewBusinessLogicError("PR is not open for review")
+	}
+
+	remaining := make([]string, 0, len(pr.Reviewers))
+	for _, r := range pr.Reviewers {
+		if r != in.UserID {
+			remaining = append(remaining, r)
+		}
+	}
+	if len(remaining) == len(pr.Reviewers) {
+		return nil, errors.NewBusinessLogicError("reviewer is not assigned to this PR")
+	}
+
+	author, err := s.userRepo.GetByID(ctx, pr.AuthorID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("author not found")
+	}
+
+	reviewer, err := s.userRepo.GetByID(ctx, in.UserID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("reviewer not found")
+	}
+
+	settings, err := s.teamSettings(ctx, author.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if reviewer.IsActive && settings.IsMandatoryReviewer(reviewer.ID) {
+		return nil, errors.NewBusinessLogicError("cannot remove mandatory reviewer")
+	}
+	if len(remaining) < settings.MinReviewers {
+		return nil, errors.NewBusinessLogicError(fmt.Sprintf("minimum of %d reviewers required", settings.MinReviewers))
+	}
+
+	missingSenior, err := s.seniorFilter(ctx, settings, remaining)
+	if err != nil {
+		return nil, err
+	}
+	lostSenior := missingSenior != nil && reviewer.IsActive && reviewer.IsSenior()
+	if lostSenior && settings.Fallback != models.FallbackUnderstaffed {
+		return nil, errors.NewBusinessLogicError("senior reviewer required but it is the only senior on this PR")
+	}
+
+	update := models.PullRequestUpdate{
+		ID:        pr.ID,
+		Reviewers: &remaining,
+		Version:   &pr.Version,
+	}
+	if pr.Status == models.PRStatusOpen && settings.Fallback == models.FallbackUnderstaffed &&
+		(lostSenior || len(remaining) < settings.ReviewersCountFor(pr.PullRequestSize)) {
+		update.Status = &[]models.PullRequestStatus{models.PRStatusUnderstaffed}[0]
+	}
+
+	if err := s.savePullRequest(ctx, update); err != nil {
+		return nil, err
+	}
+
+	pr.Version++
+	pr.Reviewers = remaining
+	if update.Status != nil {
+		pr.Status = *update.Status
+	}
+
+	if err := s.recordAssignments(ctx, unassignmentEvents(pr, []string{reviewer.ID}, models.ReasonManual)); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviews(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReviewerSources(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
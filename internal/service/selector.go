@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"pr-review/internal/models"
+)
+
+const (
+	StrategyRandom      = "random"
+	StrategyLeastLoaded = "least_loaded"
+	StrategyRoundRobin  = "round_robin"
+
+	StrategyPairingDiversity = "pairing_diversity"
+	StrategyWeightedRandom   = "weighted_random"
+)
+
+const DefaultPairingWindow = 10
+
+type ReviewerSelector interface {
+	Select(ctx context.Context, req SelectionRequest) ([]string, error)
+}
+
+type SelectionRequest struct {
+	TeamID      int64
+	Author      *models.User
+	PullRequest *models.PullRequest
+	Candidates  []*models.User
+	Count       int
+	Cursors     map[int64]string
+	Rand        *rand.Rand
+}
+
+func (r SelectionRequest) rng() (*rand.Rand, error) {
+	if r.Rand == nil {
+		return nil, fmt.Errorf("selection request has no random source")
+	}
+	return r.Rand, nil
+}
+
+type randomSelector struct{}
+
+func NewRandomSelector() ReviewerSelector {
+	return &randomSelector{}
+}
+
+func (r *randomSelector) Select(_ context.Context, req SelectionRequest) ([]string, error) {
+	if req.Count <= 0 || len(req.Candidates) == 0 {
+		return []string{}, nil
+	}
+
+	shuffled := make([]string, 0, len(req.Candidates))
+	for _, c := range req.Candidates {
+		shuffled = append(shuffled, c.ID)
+	}
+	sort.Strings(shuffled)
+
+	rng, err := req.rng()
+	if err != nil {
+		return nil, err
+	}
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled[:min(req.Count, len(shuffled))], nil
+}
+
+type leastLoadedSelector struct {
+	pullRequestRepo PullRequestRepository
+}
+
+func NewLeastLoadedSelector(pullRequestRepo PullRequestRepository) ReviewerSelector {
+	return &leastLoadedSelector{pullRequestRepo: pullRequestRepo}
+}
+
+func (l *leastLoadedSelector) Select(ctx context.Context, req SelectionRequest) ([]string, error) {
+	if req.Count <= 0 || len(req.Candidates) == 0 {
+		return []string{}, nil
+	}
+
+	ids := make([]string, 0, len(req.Candidates))
+	for _, c := range req.Candidates {
+		ids = append(ids, c.ID)
+	}
+
+	stats, err := l.pullRequestRepo.CountOpenReviewsByUsers(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("count open reviews: %w", err)
+	}
+
+	load := make(map[string]int64, len(stats))
+	for _, st := range stats {
+		load[st.UserID] = st.Assignments
+	}
+
+	sort.Strings(ids)
+	rng, err := req.rng()
+	if err != nil {
+		return nil, err
+	}
+	rng.Shuffle(len(ids), func(i, j int) {
+		ids[i], ids[j] = ids[j], ids[i]
+	})
+	sort.SliceStable(ids, func(i, j int) bool {
+		return load[ids[i]] < load[ids[j]]
+	})
+
+	return ids[:min(req.Count, len(ids))], nil
+}
+
+type roundRobinSelector struct {
+	rotationRepo TeamRotationRepository
+}
+
+func NewRoundRobinSelector(rotationRepo TeamRotationRepository) ReviewerSelector {
+	return &roundRobinSelector{rotationRepo: rotationRepo}
+}
+
+func (r *roundRobinSelector) Select(ctx context.Context, req SelectionRequest) ([]string, error) {
+	if req.Count <= 0 || len(req.Candidates) == 0 {
+		return []string{}, nil
+	}
+
+	ids := make([]string, 0, len(req.Candidates))
+	for _, c := range req.Candidates {
+		ids = append(ids, c.ID)
+	}
+	sort.Strings(ids)
+
+	cursor, ok := req.Cursors[req.TeamID]
+	if !ok {
+		var err error
+		cursor, err = r.rotationRepo.Peek(ctx, req.TeamID)
+		if err != nil {
+			return nil, fmt.Errorf("peek team rotation: %w", err)
+		}
+	}
+
+	start := sort.Search(len(ids), func(i int) bool {
+		return ids[i] > cursor
+	})
+	count := min(req.Count, len(ids))
+	picked := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		picked = append(picked, ids[(start+i)%len(ids)])
+	}
+
+	if req.Cursors != nil {
+		req.Cursors[req.TeamID] = picked[count-1]
+	}
+
+	return picked, nil
+}
+
+type pairingDiversitySelector struct {
+	historyRepo AssignmentHistoryRepository
+	window      int
+}
+
+func NewPairingDiversitySelector(historyRepo AssignmentHistoryRepository, window int) ReviewerSelector {
+	if window <= 0 {
+		window = DefaultPairingWindow
+	}
+	return &pairingDiversitySelector{historyRepo: historyRepo, window: window}
+}
+
+func (p *pairingDiversitySelector) Select(ctx context.Context, req SelectionRequest) ([]string, error) {
+	if req.Count <= 0 || len(req.Candidates) == 0 {
+		return []string{}, nil
+	}
+
+	ids := make([]string, 0, len(req.Candidates))
+	for _, c := range req.Candidates {
+		ids = append(ids, c.ID)
+	}
+	sort.Strings(ids)
+
+	pairings := make(map[string]int64)
+	if req.Author != nil {
+		stats, err := p.historyRepo.CountRecentPairings(ctx, req.Author.ID, p.window)
+		if err != nil {
+			return nil, fmt.Errorf("count recent pairings: %w", err)
+		}
+		for _, st := range stats {
+			pairings[st.UserID] = st.Assignments
+		}
+	}
+
+	weights := make([]float64, len(ids))
+	for i, id := range ids {
+		weights[i] = 1 / float64(pairings[id]+1)
+	}
+
+	rng, err := req.rng()
+	if err != nil {
+		return nil, err
+	}
+
+	return weightedSample(rng, ids, weights, req.Count), nil
+}
+
+type weightedRandomSelector struct {
+	pullRequestRepo PullRequestRepository
+}
+
+func NewWeightedRandomSelector(pullRequestRepo PullRequestRepository) ReviewerSelector {
+	return &weightedRandomSelector{pullRequestRepo: pullRequestRepo}
+}
+
+func (w *weightedRandomSelector) Select(ctx context.Context, req SelectionRequest) ([]string, error) {
+	if req.Count <= 0 || len(req.Candidates) == 0 {
+		return []string{}, nil
+	}
+
+	candidates := append([]*models.User(nil), req.Candidates...)
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].ID < candidates[j].ID
+	})
+
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.ID)
+	}
+
+	stats, err := w.pullRequestRepo.CountOpenReviewsByUsers(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("count open reviews: %w", err)
+	}
+
+	load := make(map[string]int64, len(stats))
+	for _, st := range stats {
+		load[st.UserID] = st.Assignments
+	}
+
+	weights := make([]float64, len(candidates))
+	for i, c := range candidates {
+		weight := c.ReviewWeight
+		if weight <= 0 {
+			weight = models.DefaultReviewWeight
+		}
+		weights[i] = weight / float64(load[c.ID]+1)
+	}
+
+	rng, err := req.rng()
+	if err != nil {
+		return nil, err
+	}
+
+	return weightedSample(rng, ids, weights, req.Count), nil
+}
+
+func weightedSample(rng *rand.Rand, ids []string, weights []float64, count int) []string {
+	count = min(count, len(ids))
+	picked := make([]string, 0, count)
+	for len(picked) < count {
+		total := 0.0
+		for _, w := range weights {
+			total += w
+		}
+
+		target := rng.Float64() * total
+		chosen := -1
+		for i, w := range weights {
+			if w == 0 {
+				continue
+			}
+			chosen = i
+			if target < w {
+				break
+			}
+			target -= w
+		}
+
+		picked = append(picked, ids[chosen])
+		weights[chosen] = 0
+	}
+
+	return picked
+}
+
+func defaultSelectors(config *Config) map[string]ReviewerSelector {
+	return map[string]ReviewerSelector{
+		StrategyRandom:           NewRandomSelector(),
+		StrategyLeastLoaded:      NewLeastLoadedSelector(config.PullRequestRepo),
+		StrategyRoundRobin:       NewRoundRobinSelector(config.RotationRepo),
+		StrategyPairingDiversity: NewPairingDiversitySelector(config.HistoryRepo, config.PairingWindow),
+		StrategyWeightedRandom:   NewWeightedRandomSelector(config.PullRequestRepo),
+	}
+}
+
+func (s *Service) strategyForTeam(ctx context.Context, settings *models.TeamSettings) string {
+	if settings.Strategy != "" {
+		return settings.Strategy
+	}
+	if len(s.teamStrategies) == 0 {
+		return s.defaultStrategy
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, settings.TeamID)
+	if err != nil {
+		return s.defaultStrategy
+	}
+	if strategy, ok := s.teamStrategies[team.Name]; ok {
+		return strategy
+	}
+
+	return s.defaultStrategy
+}
+
+func (s *Service) selectReviewers(ctx context.Context, settings *models.TeamSettings, req SelectionRequest) ([]string, error) {
+	strategy := s.strategyForTeam(ctx, settings)
+
+	selector, ok := s.selectors[strategy]
+	if !ok {
+		return nil, fmt.Errorf("unknown reviewer selection strategy %q", strategy)
+	}
+
+	if req.Rand == nil {
+		req.Rand = s.rngFor(req.PullRequest)
+	}
+
+	reviewers := make([]string, 0, req.Count)
+	for _, tier := range s.selectionTiers(settings, req) {
+		missing := req.Count - len(reviewers)
+		if missing <= 0 {
+			break
+		}
+
+		tierReq := req
+		tierReq.Candidates = tier
+		tierReq.Count = missing
+
+		picked, err := selector.Select(ctx, tierReq)
+		if err != nil {
+			return nil, fmt.Errorf("select reviewers with %s strategy: %w", strategy, err)
+		}
+		reviewers = append(reviewers, picked...)
+	}
+
+	return reviewers, nil
+}
+
+func (s *Service) selectionTiers(settings *models.TeamSettings, req SelectionRequest) [][]*models.User {
+	if !settings.PreferWorkingHours {
+		return expertiseTiers(req.Candidates, req.PullRequest)
+	}
+
+	now := s.now()
+	online := make([]*models.User, 0, len(req.Candidates))
+	offline := make([]*models.User, 0, len(req.Candidates))
+	for _, c := range req.Candidates {
+		if c.IsWorkingAt(now) {
+			online = append(online, c)
+		} else {
+			offline = append(offline, c)
+		}
+	}
+
+	tiers := make([][]*models.User, 0)
+	for _, group := range [][]*models.User{online, offline} {
+		if len(group) > 0 {
+			tiers = append(tiers, expertiseTiers(group, req.PullRequest)...)
+		}
+	}
+	return tiers
+}
+
+func expertiseTiers(candidates []*models.User, pr *models.PullRequest) [][]*models.User {
+	if pr == nil || len(pr.Labels) == 0 {
+		return [][]*models.User{candidates}
+	}
+
+	byScore := make(map[int][]*models.User)
+	scores := make([]int, 0)
+	for _, c := range candidates {
+		score := c.MatchScore(pr.Labels)
+		if _, ok := byScore[score]; !ok {
+			scores = append(scores, score)
+		}
+		byScore[score] = append(byScore[score], c)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(scores)))
+
+	tiers := make([][]*models.User, 0, len(scores))
+	for _, score := range scores {
+		tiers = append(tiers, byScore[score])
+	}
+	return tiers
+}
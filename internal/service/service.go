@@ -2,30 +2,113 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
+	"time"
 
 	"pr-review/internal/models"
 )
 
 type Service struct {
-	userRepo        UserRepository
-	pullRequestRepo PullRequestRepository
-	teamRepo        TeamRepository
+	userRepo         UserRepository
+	pullRequestRepo  PullRequestRepository
+	teamRepo         TeamRepository
+	rotationRepo     TeamRotationRepository
+	teamSettingsRepo TeamSettingsRepository
+	outOfOfficeRepo  OutOfOfficeRepository
+	codeOwnersRepo   CodeOwnersRepository
+	historyRepo      AssignmentHistoryRepository
+	exclusionRepo    ReviewExclusionRepository
+	reviewRepo       ReviewRepository
+	swapOfferRepo    ReviewSwapOfferRepository
+	transactor       Transactor
+	selectors        map[string]ReviewerSelector
+	defaultStrategy  string
+	teamStrategies   map[string]string
+	maxOpenReviews   int
+	randSource       rand.Source
+	now              func() time.Time
+	deterministic    bool
 }
 
 type Config struct {
-	UserRepo        UserRepository
-	PullRequestRepo PullRequestRepository
-	TeamRepo        TeamRepository
+	UserRepo         UserRepository
+	PullRequestRepo  PullRequestRepository
+	TeamRepo         TeamRepository
+	RotationRepo     TeamRotationRepository
+	TeamSettingsRepo TeamSettingsRepository
+	OutOfOfficeRepo  OutOfOfficeRepository
+	CodeOwnersRepo   CodeOwnersRepository
+	HistoryRepo      AssignmentHistoryRepository
+	ExclusionRepo    ReviewExclusionRepository
+	ReviewRepo       ReviewRepository
+	SwapOfferRepo    ReviewSwapOfferRepository
+	Transactor       Transactor
+	Selectors        map[string]ReviewerSelector
+	DefaultStrategy  string
+	TeamStrategies   map[string]string
+	MaxOpenReviews   int
+	PairingWindow    int
+	RandSource       rand.Source
+	Clock            func() time.Time
+	Deterministic    bool
 }
 
 func NewService(config *Config) (*Service, error) {
+	selectors := defaultSelectors(config)
+	for name, selector := range config.Selectors {
+		selectors[name] = selector
+	}
+
+	defaultStrategy := config.DefaultStrategy
+	if defaultStrategy == "" {
+		defaultStrategy = StrategyRandom
+	}
+	if _, ok := selectors[defaultStrategy]; !ok {
+		return nil, fmt.Errorf("unknown reviewer selection strategy %q", defaultStrategy)
+	}
+	for team, strategy := range config.TeamStrategies {
+		if _, ok := selectors[strategy]; !ok {
+			return nil, fmt.Errorf("unknown reviewer selection strategy %q for team %s", strategy, team)
+		}
+	}
+
+	clock := config.Clock
+	if clock == nil {
+		clock = time.Now
+	}
+	randSource := config.RandSource
+	if randSource == nil {
+		randSource = rand.NewSource(clock().UnixNano())
+	}
+
 	return &Service{
-		userRepo:        config.UserRepo,
-		pullRequestRepo: config.PullRequestRepo,
-		teamRepo:        config.TeamRepo,
+		userRepo:         config.UserRepo,
+		pullRequestRepo:  config.PullRequestRepo,
+		teamRepo:         config.TeamRepo,
+		rotationRepo:     config.RotationRepo,
+		teamSettingsRepo: config.TeamSettingsRepo,
+		outOfOfficeRepo:  config.OutOfOfficeRepo,
+		codeOwnersRepo:   config.CodeOwnersRepo,
+		historyRepo:      config.HistoryRepo,
+		exclusionRepo:    config.ExclusionRepo,
+		reviewRepo:       config.ReviewRepo,
+		swapOfferRepo:    config.SwapOfferRepo,
+		transactor:       config.Transactor,
+		selectors:        selectors,
+		defaultStrategy:  defaultStrategy,
+		teamStrategies:   config.TeamStrategies,
+		maxOpenReviews:   config.MaxOpenReviews,
+		randSource:       &lockedSource{src: randSource},
+		now:              clock,
+		deterministic:    config.Deterministic,
 	}, nil
 }
 
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type UserRepository interface {
 	GetByID(ctx context.Context, userID string) (*models.User, error)
 	List(ctx context.Context, filter models.ListUserFilter) ([]*models.User, error)
@@ -42,6 +125,39 @@ type TeamRepository interface {
 	List(ctx context.Context, filter models.ListTeamFilter) ([]*models.Team, error)
 }
 
+type TeamRotationRepository interface {
+	Peek(ctx context.Context, teamID int64) (string, error)
+	Save(ctx context.Context, teamID int64, cursor string) error
+}
+
+type TeamSettingsRepository interface {
+	GetByTeamID(ctx context.Context, teamID int64) (*models.TeamSettings, error)
+	Upsert(ctx context.Context, settings *models.TeamSettings) error
+	Delete(ctx context.Context, teamID int64) error
+}
+
+type OutOfOfficeRepository interface {
+	Create(ctx context.Context, o *models.OutOfOffice) error
+	GetByID(ctx context.Context, id int64) (*models.OutOfOffice, error)
+	ListByUserID(ctx context.Context, userID string) ([]*models.OutOfOffice, error)
+	ListActive(ctx context.Context, userIDs []string, at time.Time) ([]*models.OutOfOffice, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type CodeOwnersRepository interface {
+	Create(ctx context.Context, c *models.CodeOwners) error
+	GetLatest(ctx context.Context, teamID int64) (*models.CodeOwners, error)
+	GetByVersion(ctx context.Context, teamID int64, version int) (*models.CodeOwners, error)
+}
+
+type AssignmentHistoryRepository interface {
+	Record(ctx context.Context, events []models.AssignmentEvent) error
+	ListByPullRequest(ctx context.Context, pullRequestID int64) ([]*models.AssignmentEvent, error)
+	SourceTeams(ctx context.Context, pullRequestID int64) (map[string]int64, error)
+	CountRecentPairings(ctx context.Context, authorID string, lastPRs int) ([]models.UserAssignmentStat, error)
+	PairingMatrix(ctx context.Context, authorIDs []string) ([]models.PairingStat, error)
+}
+
 type PullRequestRepository interface {
 	Create(ctx context.Context, pr *models.PullRequest) error
 	GetByID(ctx context.Context, id int64) (*models.PullRequest, error)
@@ -49,5 +165,24 @@ type PullRequestRepository interface {
 	Update(ctx context.Context, u models.PullRequestUpdate) error
 	StatsAssignmentsByUser(ctx context.Context) ([]models.UserAssignmentStat, error)
 	StatsReviewersPerPR(ctx context.Context) ([]models.PRReviewersStat, error)
+	CountOpenReviewsByUsers(ctx context.Context, userIDs []string) ([]models.UserAssignmentStat, error)
 	List(ctx context.Context, filter models.ListPullRequestFilter) ([]*models.PullRequest, error)
 }
+
+type ReviewExclusionRepository interface {
+	Create(ctx context.Context, e *models.ReviewExclusion) error
+	GetByID(ctx context.Context, id int64) (*models.ReviewExclusion, error)
+	ListByUserID(ctx context.Context, userID string) ([]*models.ReviewExclusion, error)
+	ExcludedReviewers(ctx context.Context, authorID string) ([]string, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type ReviewRepository interface {
+	Create(ctx context.Context, review *models.Review) error
+	ListLatest(ctx context.Context, pullRequestIDs []int64) ([]*models.Review, error)
+}
+
+type ReviewSwapOfferRepository interface {
+	Create(ctx context.Context, offer *models.ReviewSwapOffer) error
+	Consume(ctx context.Context, pullRequestID int64, reviewerID, claimerID string) (bool, error)
+}
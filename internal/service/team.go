@@ -15,6 +15,12 @@ func (s *Service) CreateTeamWithMembers(ctx context.Context, teamName string, me
 		return nil, nil, errors.NewAlreadyExistsError("team_name already exists")
 	}
 
+	for _, member := range members {
+		if member.Seniority != "" && !models.Seniority(member.Seniority).IsValid() {
+			return nil, nil, errors.NewValidationError(fmt.Sprintf("unknown seniority %q", member.Seniority))
+		}
+	}
+
 	team := &models.Team{
 		Name: teamName,
 	}
@@ -26,10 +32,11 @@ func (s *Service) CreateTeamWithMembers(ctx context.Context, teamName string, me
 
 	for _, member := range members {
 		user := &models.User{
-			ID:       member.UserID,
-			Name:     member.Username,
-			TeamID:   team.ID,
-			IsActive: member.IsActive,
+			ID:        member.UserID,
+			Name:      member.Username,
+			TeamID:    team.ID,
+			IsActive:  member.IsActive,
+			Seniority: models.Seniority(member.Seniority),
 		}
 
 		if err := s.userRepo.Upsert(ctx, user); err != nil {
@@ -57,6 +64,10 @@ func (s *Service) GetTeamByName(ctx context.Context, teamName string) (*models.T
 		return nil, nil, fmt.Errorf("get team members: %w", err)
 	}
 
+	if err := s.fillAvailability(ctx, members); err != nil {
+		return nil, nil, err
+	}
+
 	return team, members, nil
 }
 
@@ -82,9 +93,8 @@ func (s *Service) DeactivateTeamAndReassign(ctx context.Context, teamName string
 		return 0, nil
 	}
 
-	status := models.PRStatusOpen
 	prs, err := s.pullRequestRepo.List(ctx, models.ListPullRequestFilter{
-		Status:           &status,
+		Statuses:         models.OpenStatuses,
 		ReviewersOverlap: &deactivatedIDs,
 	})
 	if err != nil {
@@ -99,19 +109,25 @@ func (s *Service) DeactivateTeamAndReassign(ctx context.Context, teamName string
 	updated := 0
 	for _, pr := range prs {
 		newReviewers := make([]string, 0)
+		removed := make([]string, 0)
 		for _, reviewerID := range pr.Reviewers {
 			if _, deactivated := deactivatedMap[reviewerID]; !deactivated {
 				newReviewers = append(newReviewers, reviewerID)
+			} else {
+				removed = append(removed, reviewerID)
 			}
 		}
 
-		if len(newReviewers) < len(pr.Reviewers) {
+		if len(removed) > 0 {
 			upd := models.PullRequestUpdate{
 				ID:        pr.ID,
 				Reviewers: &newReviewers,
 			}
 			if err := s.pullRequestRepo.Update(ctx, upd); err == nil {
 				updated++
+				if err := s.recordAssignments(ctx, unassignmentEvents(pr, removed, models.ReasonDeactivated)); err != nil {
+					return updated, err
+				}
 			}
 		}
 	}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"pr-review/internal/errors"
+	"pr-review/internal/models"
+)
+
+func (s *Service) teamSettings(ctx context.Context, teamID int64) (*models.TeamSettings, error) {
+	settings, err := s.teamSettingsRepo.GetByTeamID(ctx, teamID)
+	if errors.IsNotFound(err) || (err == nil && settings == nil) {
+		return models.DefaultTeamSettings(teamID), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get team settings: %w", err)
+	}
+	return settings, nil
+}
+
+func (s *Service) GetTeamSettings(ctx context.Context, teamName string) (*models.Team, *models.TeamSettings, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return nil, nil, errors.NewNotFoundError("team not found")
+	}
+
+	settings, err := s.teamSettings(ctx, team.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return team, settings, nil
+}
+
+func (s *Service) SetTeamSettings(ctx context.Context, teamName string, update models.TeamSettingsUpdate) (*models.Team, *models.TeamSettings, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return nil, nil, errors.NewNotFoundError("team not found")
+	}
+
+	settings, err := s.teamSettings(ctx, team.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	settings.Apply(update)
+
+	if update.FallbackTeams != nil {
+		fallbackTeams, err := s.resolveFallbackTeams(ctx, team, *update.FallbackTeams)
+		if err != nil {
+			return nil, nil, err
+		}
+		settings.FallbackTeams = fallbackTeams
+	}
+
+	if update.MandatoryReviewers != nil {
+		mandatory, err := s.resolveMandatoryReviewers(ctx, *update.MandatoryReviewers)
+		if err != nil {
+			return nil, nil, err
+		}
+		settings.MandatoryReviewers = mandatory
+	}
+
+	if err := s.validateTeamSettings(settings); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.teamSettingsRepo.Upsert(ctx, settings); err != nil {
+		return nil, nil, fmt.Errorf("save team settings: %w", err)
+	}
+
+	return team, settings, nil
+}
+
+func (s *Service) DeleteTeamSettings(ctx context.Context, teamName string) (*models.Team, *models.TeamSettings, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return nil, nil, errors.NewNotFoundError("team not found")
+	}
+
+	if err := s.teamSettingsRepo.Delete(ctx, team.ID); err != nil {
+		return nil, nil, fmt.Errorf("delete team settings: %w", err)
+	}
+
+	return team, models.DefaultTeamSettings(team.ID), nil
+}
+
+func (s *Service) resolveFallbackTeams(ctx context.Context, team *models.Team, names []string) ([]models.Team, error) {
+	out := make([]models.Team, 0, len(names))
+	seen := make(map[int64]bool, len(names))
+	for _, name := range names {
+		fallback, err := s.teamRepo.GetByName(ctx, name)
+		if err != nil {
+			return nil, errors.NewNotFoundError(fmt.Sprintf("fallback team %s not found", name))
+		}
+		if fallback.ID == team.ID {
+			return nil, errors.NewValidationError("team cannot be its own fallback")
+		}
+		if seen[fallback.ID] {
+			continue
+		}
+		seen[fallback.ID] = true
+		out = append(out, *fallback)
+	}
+
+	return out, nil
+}
+
+func (s *Service) resolveMandatoryReviewers(ctx context.Context, userIDs []string) ([]string, error) {
+	out := make([]string, 0, len(userIDs))
+	seen := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if seen[userID] {
+			continue
+		}
+		if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+			return nil, errors.NewNotFoundError(fmt.Sprintf("mandatory reviewer %s not found", userID))
+		}
+		seen[userID] = true
+		out = append(out, userID)
+	}
+
+	return out, nil
+}
+
+func (s *Service) validateTeamSettings(settings *models.TeamSettings) error {
+	if settings.ReviewersCount < 0 {
+		return errors.NewValidationError("reviewers_count must not be negative")
+	}
+	if settings.MinApprovals < 0 {
+		return errors.NewValidationError("min_approvals must not be negative")
+	}
+	if settings.MinApprovals > settings.ReviewersCount {
+		return errors.NewValidationError("min_approvals must not exceed reviewers_count")
+	}
+	if settings.Strategy != "" {
+		if _, ok := s.selectors[settings.Strategy]; !ok {
+			return errors.NewValidationError(fmt.Sprintf("unknown strategy %q", settings.Strategy))
+		}
+	}
+	for _, rule := range settings.SizeRules {
+		if rule.MaxLines < 0 || rule.MaxFiles < 0 {
+			return errors.NewValidationError("size rule limits must not be negative")
+		}
+		if rule.ReviewersCount < settings.MinApprovals {
+			return errors.NewValidationError("size rule reviewers_count must not be less than min_approvals")
+		}
+		if settings.MaxReviewers > 0 && rule.ReviewersCount > settings.MaxReviewers {
+			return errors.NewValidationError("size rule reviewers_count must not exceed max_reviewers")
+		}
+	}
+	if settings.MinReviewers < 0 || settings.MaxReviewers < 0 {
+		return errors.NewValidationError("min_reviewers and max_reviewers must not be negative")
+	}
+	if settings.MinReviewers > settings.ReviewersCount {
+		return errors.NewValidationError("min_reviewers must not exceed reviewers_count")
+	}
+	if settings.MaxReviewers > 0 && settings.MaxReviewers < settings.ReviewersCount {
+		return errors.NewValidationError("max_reviewers must not be less than reviewers_count")
+	}
+	switch settings.Fallback {
+	case models.FallbackAssignAvailable, models.FallbackUnderstaffed, models.FallbackReject:
+	default:
+		return errors.NewValidationError(fmt.Sprintf("unknown fallback %q", settings.Fallback))
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"pr-review/internal/errors"
 	"pr-review/internal/models"
@@ -27,13 +29,164 @@ func (s *Service) SetUserIsActive(ctx context.Context, userID string, isActive b
 	return user, nil
 }
 
+func (s *Service) SetUserMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*models.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("user not found")
+	}
+
+	if maxOpenReviews != nil && *maxOpenReviews < 0 {
+		return nil, errors.NewValidationError("max_open_reviews must not be negative")
+	}
+
+	update := models.UserUpdate{
+		ID:                  userID,
+		MaxOpenReviews:      maxOpenReviews,
+		ClearMaxOpenReviews: maxOpenReviews == nil,
+	}
+
+	if err := s.userRepo.Update(ctx, update); err != nil {
+		return nil, fmt.Errorf("update user: %w", err)
+	}
+
+	user.MaxOpenReviews = maxOpenReviews
+	return user, nil
+}
+
+func (s *Service) SetUserSeniority(ctx context.Context, userID string, seniority models.Seniority) (*models.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("user not found")
+	}
+
+	if !seniority.IsValid() {
+		return nil, errors.NewValidationError(fmt.Sprintf("unknown seniority %q", seniority))
+	}
+
+	update := models.UserUpdate{
+		ID:        userID,
+		Seniority: &seniority,
+	}
+
+	if err := s.userRepo.Update(ctx, update); err != nil {
+		return nil, fmt.Errorf("update user: %w", err)
+	}
+
+	user.Seniority = seniority
+	return user, nil
+}
+
+func (s *Service) SetUserReviewWeight(ctx context.Context, userID string, weight float64) (*models.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("user not found")
+	}
+
+	if weight <= 0 {
+		return nil, errors.NewValidationError("review_weight must be positive")
+	}
+
+	update := models.UserUpdate{
+		ID:           userID,
+		ReviewWeight: &weight,
+	}
+
+	if err := s.userRepo.Update(ctx, update); err != nil {
+		return nil, fmt.Errorf("update user: %w", err)
+	}
+
+	user.ReviewWeight = weight
+	return user, nil
+}
+
+func (s *Service) SetUserExpertise(ctx context.Context, userID string, tags []string) (*models.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("user not found")
+	}
+
+	expertise := normalizeTags(tags)
+	update := models.UserUpdate{
+		ID:        userID,
+		Expertise: &expertise,
+	}
+
+	if err := s.userRepo.Update(ctx, update); err != nil {
+		return nil, fmt.Errorf("update user: %w", err)
+	}
+
+	user.Expertise = expertise
+	return user, nil
+}
+
+func (s *Service) SetUserWorkingHours(ctx context.Context, userID string, hours models.WorkingHours) (*models.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.NewNotFoundError("user not found")
+	}
+
+	if hours.TimeZone == "" {
+		hours.TimeZone = models.DefaultTimeZone
+	}
+	if _, err := time.LoadLocation(hours.TimeZone); err != nil {
+		return nil, errors.NewValidationError(fmt.Sprintf("unknown time_zone %q", hours.TimeZone))
+	}
+	if (hours.Start == nil) != (hours.End == nil) {
+		return nil, errors.NewValidationError("work_start and work_end must be set together")
+	}
+	for _, clock := range []*string{hours.Start, hours.End} {
+		if clock == nil {
+			continue
+		}
+		if _, err := models.ParseClock(*clock); err != nil {
+			return nil, errors.NewValidationError(fmt.Sprintf("invalid time %q, expected HH:MM", *clock))
+		}
+	}
+	if hours.Start != nil && *hours.Start == *hours.End {
+		return nil, errors.NewValidationError("work_start and work_end must differ")
+	}
+
+	update := models.UserUpdate{
+		ID:           userID,
+		WorkingHours: &hours,
+	}
+
+	if err := s.userRepo.Update(ctx, update); err != nil {
+		return nil, fmt.Errorf("update user: %w", err)
+	}
+
+	user.TimeZone = hours.TimeZone
+	user.WorkStart = hours.Start
+	user.WorkEnd = hours.End
+	return user, nil
+}
+
+func normalizeTags(tags []string) []string {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		out = append(out, tag)
+	}
+	return out
+}
+
 func (s *Service) ListUserReviews(ctx context.Context, reviewerIDStr string) ([]*models.PullRequest, error) {
 	prs, err := s.pullRequestRepo.List(ctx, models.ListPullRequestFilter{
 		ReviewerID: &reviewerIDStr,
+		Statuses:   models.ReviewStatuses,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("list reviews by reviewer: %w", err)
 	}
 
+	if err := s.fillReviews(ctx, prs...); err != nil {
+		return nil, err
+	}
+
 	return prs, nil
 }